@@ -20,10 +20,12 @@ locals {
   stage    = var.environment
 }
 
+# Inputs are passed as vars, so template functions can format them (here: zero-padding)
 data "idgen_templated" "example" {
-  template = "0q-{{ .proquint }}-{{ .nanoid }}-s${local.size_fmt}${local.stage}"
+  template = "0q-{{ .proquint }}-{{ .nanoid }}-s{{ .vars.size | pad_left 3 \"0\" }}{{ .vars.stage }}"
   nanoid   = { length = 3, seed = "#${local.size}_${local.seed}", alphabet = "readable" }
   proquint = { length = 11, seed = "#${local.size}_${local.seed}" }
+  vars     = { size = local.size, stage = local.stage }
 }
 
 # Multiple variations showing different parametrization patterns
@@ -48,8 +50,9 @@ data "idgen_templated" "database_name" {
 
 # S3 bucket with region and stage
 data "idgen_templated" "s3_bucket" {
-  template = "${lower(var.environment)}-${replace(var.region, "_", "-")}-0q-{{ .proquint }}"
+  template = "{{ .vars.environment | lower }}-{{ .vars.region | replace \"_\" \"-\" }}-0q-{{ .proquint }}"
   proquint = { seed = "${var.app_seed}-storage" }
+  vars     = { environment = var.environment, region = var.region }
 }
 
 output "templated_id_basic" {
@@ -10,13 +10,66 @@ import (
 //go:embed five_letter_words.txt
 var fiveLetterWords string
 
+//go:embed bip39_english.txt
+var bip39English string
+
+//go:embed eff_large_wordlist.txt
+var effLargeWordlist string
+
+//go:embed eff_short_wordlist_2_0.txt
+var effShortWordlist string
+
+//go:embed petname_adjectives.txt
+var petnameAdjectives string
+
+//go:embed petname_nouns.txt
+var petnameNouns string
+
+//go:embed petname_animals.txt
+var petnameAnimals string
+
 // FiveLetterWords is a predefined word list of common five-letter English words.
 // It is sorted and contains no blank values.
 var FiveLetterWords []string
 
+// BIP39English is the standard 2048-word English list of the BIP39 specification
+// (https://github.com/bitcoin/bips/blob/master/bip-0039/english.txt).
+// Unlike the other word lists, its order is significant: a word's position is its 11-bit value.
+var BIP39English []string
+
+// EFFLargeWords is the EFF large word list for passphrases (7776 words, five dice rolls per word).
+// See https://www.eff.org/deeplinks/2016/07/new-wordlists-random-passphrases
+var EFFLargeWords []string
+
+// EFFShortWords is the EFF short word list 2.0 (1296 words, four dice rolls per word).
+// Each word has a unique three-character prefix, which makes it suitable for autocompletion.
+var EFFShortWords []string
+
+// PetnameAdjectives, PetnameNouns and PetnameAnimals are the curated word lists used to
+// build petnames such as "brave-otter". They follow the same design philosophy as
+// FiveLetterWords, and are sorted and contain no blank values.
+var (
+	PetnameAdjectives []string
+	PetnameNouns      []string
+	PetnameAnimals    []string
+)
+
 func init() {
+	FiveLetterWords = parseWordSet(fiveLetterWords)
+	PetnameAdjectives = parseWordSet(petnameAdjectives)
+	PetnameNouns = parseWordSet(petnameNouns)
+	PetnameAnimals = parseWordSet(petnameAnimals)
+
+	BIP39English = strings.Fields(bip39English)
+	EFFLargeWords = parseDicewareList(effLargeWordlist)
+	EFFShortWords = parseDicewareList(effShortWordlist)
+}
+
+// parseWordSet parses a list of one word per line, skipping blank lines and "#" comments.
+// The result is deduplicated and sorted so that seeded selection does not depend on file order.
+func parseWordSet(list string) []string {
 	wordSet := make(map[string]struct{})
-	sc := bufio.NewScanner(strings.NewReader(fiveLetterWords))
+	sc := bufio.NewScanner(strings.NewReader(list))
 	for sc.Scan() {
 		// Trim whitespace and check if the line is a comment or empty
 		if word := strings.TrimSpace(sc.Text()); word != "" && !strings.HasPrefix(word, "#") {
@@ -25,11 +78,24 @@ func init() {
 	}
 
 	// Convert to slice and sort
-	FiveLetterWords = make([]string, 0, len(wordSet))
+	words := make([]string, 0, len(wordSet))
 	for word := range wordSet {
-		FiveLetterWords = append(FiveLetterWords, word)
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	return words
+}
+
+// parseDicewareList parses a list of "<dice rolls>\t<word>" lines, keeping the order of the dice rolls.
+func parseDicewareList(list string) []string {
+	var words []string
+	sc := bufio.NewScanner(strings.NewReader(list))
+	for sc.Scan() {
+		if fields := strings.Fields(sc.Text()); len(fields) == 2 {
+			words = append(words, fields[1])
+		}
 	}
-	sort.Strings(FiveLetterWords)
+	return words
 }
 
 var WordSet map[string]struct{}
@@ -1,6 +1,8 @@
 package data
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"strings"
 	"testing"
 )
@@ -62,3 +64,107 @@ func TestFiveLetterWords(t *testing.T) {
 		}
 	})
 }
+
+func TestBIP39English(t *testing.T) {
+	t.Run("matches the published list", func(t *testing.T) {
+		// sha256 of https://github.com/bitcoin/bips/blob/master/bip-0039/english.txt
+		want := "2f5eed53a4727b4bf8880d8f3f199efc90e58503646d9ff8eff3a2ed3b24dbda"
+		sum := sha256.Sum256([]byte(bip39English))
+		if got := hex.EncodeToString(sum[:]); got != want {
+			t.Errorf("bip39_english.txt sha256 = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("contains 2048 words in order", func(t *testing.T) {
+		if len(BIP39English) != 2048 {
+			t.Fatalf("BIP39English length = %d, want 2048", len(BIP39English))
+		}
+		if BIP39English[0] != "abandon" || BIP39English[2047] != "zoo" {
+			t.Errorf("BIP39English first/last = %q/%q, want abandon/zoo", BIP39English[0], BIP39English[2047])
+		}
+	})
+}
+
+func TestEFFWords(t *testing.T) {
+	tests := []struct {
+		name   string
+		list   string
+		words  []string
+		sha256 string
+		count  int
+		first  string
+		last   string
+	}{
+		{
+			name:   "large",
+			list:   effLargeWordlist,
+			words:  EFFLargeWords,
+			sha256: "addd35536511597a02fa0a9ff1e5284677b8883b83e986e43f15a3db996b903e",
+			count:  7776,
+			first:  "abacus",
+			last:   "zoom",
+		},
+		{
+			name:   "short",
+			list:   effShortWordlist,
+			words:  EFFShortWords,
+			sha256: "22b45c52e0bd0bbf03aa522240b111eb4c7c0c1d86c4e518e1be2a7eb2a625e4",
+			count:  1296,
+			first:  "aardvark",
+			last:   "zucchini",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum := sha256.Sum256([]byte(tt.list))
+			if got := hex.EncodeToString(sum[:]); got != tt.sha256 {
+				t.Errorf("sha256 = %s, want %s", got, tt.sha256)
+			}
+
+			if len(tt.words) != tt.count {
+				t.Fatalf("length = %d, want %d", len(tt.words), tt.count)
+			}
+			if tt.words[0] != tt.first || tt.words[len(tt.words)-1] != tt.last {
+				t.Errorf("first/last = %q/%q, want %q/%q", tt.words[0], tt.words[len(tt.words)-1], tt.first, tt.last)
+			}
+
+			seen := make(map[string]bool)
+			for _, word := range tt.words {
+				if seen[word] {
+					t.Errorf("duplicate word %q", word)
+				}
+				seen[word] = true
+			}
+		})
+	}
+}
+
+func TestPetnameWords(t *testing.T) {
+	lists := map[string][]string{
+		"adjectives": PetnameAdjectives,
+		"nouns":      PetnameNouns,
+		"animals":    PetnameAnimals,
+	}
+
+	for name, words := range lists {
+		t.Run(name, func(t *testing.T) {
+			if len(words) < 50 {
+				t.Fatalf("length = %d, want at least 50", len(words))
+			}
+
+			for i, word := range words {
+				// Words are joined by arbitrary separators, so they must be plain lowercase letters
+				for _, r := range word {
+					if r < 'a' || r > 'z' {
+						t.Errorf("word %q contains %q, want only a-z", word, r)
+						break
+					}
+				}
+				if i > 0 && words[i-1] >= word {
+					t.Errorf("not sorted or duplicated: %q >= %q at index %d", words[i-1], word, i-1)
+				}
+			}
+		})
+	}
+}
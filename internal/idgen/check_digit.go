@@ -0,0 +1,202 @@
+package idgen
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// CheckDigitLuhnModN is the Luhn mod N algorithm, which works with alphabets of any size.
+	CheckDigitLuhnModN = "luhn_mod_n"
+
+	// CheckDigitDamm is the Damm algorithm, which requires an alphabet of exactly 10 characters.
+	CheckDigitDamm = "damm"
+
+	// CheckDigitVerhoeff is the Verhoeff algorithm, which requires an alphabet of exactly 10 characters.
+	CheckDigitVerhoeff = "verhoeff"
+)
+
+// dammTable is the totally anti-symmetric quasigroup of order 10 used by the Damm algorithm.
+var dammTable = [10][10]int{
+	{0, 3, 1, 7, 5, 9, 8, 6, 4, 2},
+	{7, 0, 9, 2, 1, 5, 4, 8, 6, 3},
+	{4, 2, 0, 6, 8, 7, 1, 3, 5, 9},
+	{1, 7, 5, 0, 9, 8, 3, 4, 2, 6},
+	{6, 1, 2, 3, 0, 4, 5, 9, 7, 8},
+	{3, 6, 7, 4, 2, 0, 9, 5, 8, 1},
+	{5, 8, 6, 9, 7, 2, 0, 1, 3, 4},
+	{8, 9, 4, 5, 3, 6, 2, 0, 1, 7},
+	{9, 4, 3, 8, 6, 1, 7, 2, 0, 5},
+	{2, 5, 8, 1, 4, 3, 6, 7, 9, 0},
+}
+
+// verhoeffMultiplication is the multiplication table of the dihedral group D5.
+var verhoeffMultiplication = [10][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+	{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+	{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+	{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+	{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+	{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+	{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+	{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+	{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+}
+
+// verhoeffPermutation is the position-dependent permutation table of the Verhoeff algorithm.
+var verhoeffPermutation = [8][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+	{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+	{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+	{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+	{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+	{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+	{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+}
+
+// verhoeffInverse holds the inverse of each element of D5.
+var verhoeffInverse = [10]int{0, 4, 3, 2, 1, 5, 6, 7, 8, 9}
+
+// ValidateCheckDigitAlgorithm checks that algorithm is supported for the alphabet.
+// The alphabet must not contain duplicate characters, as the position of a character
+// in the alphabet is its value. Alphabet sizes are counted in characters, not bytes.
+func ValidateCheckDigitAlgorithm(algorithm, alphabet string) error {
+	seen := make(map[rune]bool, len(alphabet))
+	for _, r := range alphabet {
+		if seen[r] {
+			return fmt.Errorf("check digits require an alphabet without duplicate characters, %q appears more than once", r)
+		}
+		seen[r] = true
+	}
+
+	size := utf8.RuneCountInString(alphabet)
+	switch algorithm {
+	case CheckDigitLuhnModN:
+		if size < 2 {
+			return errors.New("luhn_mod_n requires an alphabet of at least 2 characters")
+		}
+	case CheckDigitDamm, CheckDigitVerhoeff:
+		if size != 10 {
+			return fmt.Errorf("%s requires an alphabet of exactly 10 characters (e.g. numeric), got %d", algorithm, size)
+		}
+	default:
+		return fmt.Errorf("unsupported check digit algorithm %q (expected %q, %q or %q)",
+			algorithm, CheckDigitLuhnModN, CheckDigitDamm, CheckDigitVerhoeff)
+	}
+	return nil
+}
+
+// ComputeCheckCharacter returns the check character for payload, whose characters are
+// interpreted as digits by their position in alphabet.
+func ComputeCheckCharacter(payload, alphabet, algorithm string) (rune, error) {
+	if err := ValidateCheckDigitAlgorithm(algorithm, alphabet); err != nil {
+		return 0, err
+	}
+	symbols := []rune(alphabet)
+	digits, err := checkDigitValues([]rune(payload), symbols)
+	if err != nil {
+		return 0, err
+	}
+
+	var check int
+	switch algorithm {
+	case CheckDigitLuhnModN:
+		check = (len(symbols) - luhnModNSum(digits, len(symbols), 2)) % len(symbols)
+	case CheckDigitDamm:
+		check = dammInterim(digits)
+	case CheckDigitVerhoeff:
+		check = verhoeffInverse[verhoeffChecksum(digits, 1)]
+	}
+
+	return symbols[check], nil
+}
+
+// ValidateCheckCharacter verifies that the last character of code is the correct check
+// character for the preceding characters. Grouping dashes are ignored unless the
+// alphabet itself contains a dash.
+func ValidateCheckCharacter(code, alphabet, algorithm string) error {
+	if err := ValidateCheckDigitAlgorithm(algorithm, alphabet); err != nil {
+		return err
+	}
+	if !strings.Contains(alphabet, "-") {
+		code = strings.ReplaceAll(code, "-", "")
+	}
+	characters := []rune(code)
+	if len(characters) < 2 {
+		return errors.New("code must contain at least one character and a check character")
+	}
+	symbols := []rune(alphabet)
+	digits, err := checkDigitValues(characters, symbols)
+	if err != nil {
+		return err
+	}
+
+	var valid bool
+	switch algorithm {
+	case CheckDigitLuhnModN:
+		valid = luhnModNSum(digits, len(symbols), 1) == 0
+	case CheckDigitDamm:
+		valid = dammInterim(digits) == 0
+	case CheckDigitVerhoeff:
+		valid = verhoeffChecksum(digits, 0) == 0
+	}
+
+	if !valid {
+		return fmt.Errorf("check character %q does not match the preceding characters (%s)", characters[len(characters)-1], algorithm)
+	}
+	return nil
+}
+
+// checkDigitValues maps each character of s to its position in alphabet.
+func checkDigitValues(s, alphabet []rune) ([]int, error) {
+	values := make(map[rune]int, len(alphabet))
+	for i, r := range alphabet {
+		values[r] = i
+	}
+
+	digits := make([]int, len(s))
+	for i, r := range s {
+		digit, ok := values[r]
+		if !ok {
+			return nil, fmt.Errorf("character %d (%q) is not in the alphabet", i+1, r)
+		}
+		digits[i] = digit
+	}
+	return digits, nil
+}
+
+// luhnModNSum returns the Luhn mod N sum of digits, doubling every second digit
+// from the right starting with the given factor (2 to compute, 1 to validate).
+func luhnModNSum(digits []int, n, factor int) int {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		addend := factor * digits[i]
+		factor = 3 - factor
+		sum += addend/n + addend%n
+	}
+	return sum % n
+}
+
+// dammInterim returns the interim digit of the Damm algorithm, which is 0 for a valid code.
+func dammInterim(digits []int) int {
+	interim := 0
+	for _, digit := range digits {
+		interim = dammTable[interim][digit]
+	}
+	return interim
+}
+
+// verhoeffChecksum returns the Verhoeff checksum of digits, processed from the right with
+// position offset 1 to compute a check digit, or 0 to validate a code.
+func verhoeffChecksum(digits []int, offset int) int {
+	c := 0
+	for i := 0; i < len(digits); i++ {
+		digit := digits[len(digits)-1-i]
+		c = verhoeffMultiplication[c][verhoeffPermutation[(i+offset)%8][digit]]
+	}
+	return c
+}
@@ -0,0 +1,180 @@
+package idgen
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestComputeCheckCharacter(t *testing.T) {
+	tests := []struct {
+		name      string
+		payload   string
+		alphabet  string
+		algorithm string
+		expected  rune
+	}{
+		// Luhn mod 10 is the classic Luhn algorithm
+		{"luhn mod 10", "7992739871", Numeric, CheckDigitLuhnModN, '3'},
+		{"luhn mod 6", "abcdef", "abcdef", CheckDigitLuhnModN, 'e'},
+		{"damm", "572", Numeric, CheckDigitDamm, '4'},
+		{"verhoeff", "236", Numeric, CheckDigitVerhoeff, '3'},
+		{"verhoeff longer", "12345", Numeric, CheckDigitVerhoeff, '1'},
+		// The position in the alphabet is the digit value, so any 10 characters work
+		{"damm custom alphabet", "fhc", "abcdefghij", CheckDigitDamm, 'e'},
+		// Alphabets are indexed by character, so multi-byte characters work like ASCII
+		{"luhn mod 6 non-ascii", "αβγδεζ", "αβγδεζ", CheckDigitLuhnModN, 'ε'},
+		{"damm non-ascii", "ζθγ", "αβγδεζηθικ", CheckDigitDamm, 'ε'},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check, err := ComputeCheckCharacter(tt.payload, tt.alphabet, tt.algorithm)
+			if err != nil {
+				t.Fatalf("ComputeCheckCharacter() error = %v", err)
+			}
+			if check != tt.expected {
+				t.Errorf("ComputeCheckCharacter(%q) = %q, want %q", tt.payload, check, tt.expected)
+			}
+			if err := ValidateCheckCharacter(tt.payload+string(check), tt.alphabet, tt.algorithm); err != nil {
+				t.Errorf("ValidateCheckCharacter() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateCheckCharacter(t *testing.T) {
+	t.Run("detects single substitutions and adjacent transpositions", func(t *testing.T) {
+		for _, algorithm := range []string{CheckDigitLuhnModN, CheckDigitDamm, CheckDigitVerhoeff} {
+			code := "8473920156"
+			check, _ := ComputeCheckCharacter(code, Numeric, algorithm)
+			code += string(check)
+
+			for i := 0; i < len(code); i++ {
+				for _, r := range Numeric {
+					if byte(r) == code[i] {
+						continue
+					}
+					typo := code[:i] + string(r) + code[i+1:]
+					if ValidateCheckCharacter(typo, Numeric, algorithm) == nil {
+						t.Errorf("%s: substitution %q~>%q not detected", algorithm, code, typo)
+					}
+				}
+			}
+
+			// Luhn does not detect the 09 <-> 90 transposition
+			if algorithm == CheckDigitLuhnModN {
+				continue
+			}
+			for i := 0; i+1 < len(code); i++ {
+				if code[i] == code[i+1] {
+					continue
+				}
+				typo := code[:i] + string(code[i+1]) + string(code[i]) + code[i+2:]
+				if ValidateCheckCharacter(typo, Numeric, algorithm) == nil {
+					t.Errorf("%s: transposition %q~>%q not detected", algorithm, code, typo)
+				}
+			}
+		}
+	})
+
+	t.Run("ignores grouping dashes", func(t *testing.T) {
+		if err := ValidateCheckCharacter("799-273-987-13", Numeric, CheckDigitLuhnModN); err != nil {
+			t.Errorf("ValidateCheckCharacter() error = %v", err)
+		}
+	})
+
+	errorTests := []struct {
+		name      string
+		code      string
+		alphabet  string
+		algorithm string
+		errPart   string
+	}{
+		{"mismatch", "79927398714", Numeric, CheckDigitLuhnModN, "does not match"},
+		{"unknown character", "79x27398713", Numeric, CheckDigitLuhnModN, `character 3 ('x') is not in the alphabet`},
+		{"unknown non-ascii character", "αβxγ", "αβγδ", CheckDigitLuhnModN, `character 3 ('x') is not in the alphabet`},
+		{"too short non-ascii", "α", "αβγδεζηθικ", CheckDigitDamm, "at least one character"},
+		{"damm counts characters", "αβγ", "αβγδε", CheckDigitDamm, "exactly 10 characters (e.g. numeric), got 5"},
+		{"too short", "7", Numeric, CheckDigitDamm, "at least one character"},
+		{"damm needs 10 characters", "abc", "abcdef", CheckDigitDamm, "exactly 10 characters"},
+		{"duplicate alphabet characters", "abc", "abca", CheckDigitLuhnModN, "duplicate"},
+		{"unknown algorithm", "123", Numeric, "iso7064", "unsupported check digit algorithm"},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCheckCharacter(tt.code, tt.alphabet, tt.algorithm)
+			if err == nil || !strings.Contains(err.Error(), tt.errPart) {
+				t.Errorf("ValidateCheckCharacter(%q) error = %v, want it to contain %q", tt.code, err, tt.errPart)
+			}
+		})
+	}
+}
+
+func TestGenerateNanoIDWithCheckDigit(t *testing.T) {
+	t.Run("length includes check character and grouping", func(t *testing.T) {
+		for _, algorithm := range []string{CheckDigitLuhnModN, CheckDigitDamm, CheckDigitVerhoeff} {
+			id, err := GenerateNanoIDWithCheckDigit(Numeric, 14, nil, 4, algorithm)
+			if err != nil {
+				t.Fatalf("GenerateNanoIDWithCheckDigit(%s) error = %v", algorithm, err)
+			}
+			if len(id) != 14 {
+				t.Errorf("GenerateNanoIDWithCheckDigit(%s) = %q, length %d, want 14", algorithm, id, len(id))
+			}
+			if err := ValidateCheckCharacter(id, Numeric, algorithm); err != nil {
+				t.Errorf("ValidateCheckCharacter(%q) error = %v", id, err)
+			}
+		}
+	})
+
+	t.Run("non-ascii alphabet", func(t *testing.T) {
+		alphabet := "αβγδεζηθικ"
+		id, err := GenerateNanoIDWithCheckDigit(alphabet, 8, nil, 0, CheckDigitVerhoeff)
+		if err != nil {
+			t.Fatalf("GenerateNanoIDWithCheckDigit() error = %v", err)
+		}
+		if n := utf8.RuneCountInString(id); n != 8 {
+			t.Errorf("GenerateNanoIDWithCheckDigit() = %q, %d characters, want 8", id, n)
+		}
+		if err := ValidateCheckCharacter(id, alphabet, CheckDigitVerhoeff); err != nil {
+			t.Errorf("ValidateCheckCharacter(%q) error = %v", id, err)
+		}
+	})
+
+	t.Run("seeded non-ascii alphabet", func(t *testing.T) {
+		alphabet := "αβγδεζηθικ"
+		seed := int64(42)
+		id, err := GenerateNanoIDWithCheckDigit(alphabet, 8, &seed, 0, CheckDigitDamm)
+		if err != nil {
+			t.Fatalf("GenerateNanoIDWithCheckDigit() error = %v", err)
+		}
+		if !utf8.ValidString(id) || utf8.RuneCountInString(id) != 8 {
+			t.Errorf("GenerateNanoIDWithCheckDigit() = %q, want 8 valid characters", id)
+		}
+		if err := ValidateCheckCharacter(id, alphabet, CheckDigitDamm); err != nil {
+			t.Errorf("ValidateCheckCharacter(%q) error = %v", id, err)
+		}
+	})
+
+	t.Run("seeded payload matches the ID without check character", func(t *testing.T) {
+		seed := int64(42)
+		plain, _ := GenerateNanoID(Readable, 11, &seed, 0)
+		checked, err := GenerateNanoIDWithCheckDigit(Readable, 12, &seed, 0, CheckDigitLuhnModN)
+		if err != nil {
+			t.Fatalf("GenerateNanoIDWithCheckDigit() error = %v", err)
+		}
+		if checked[:11] != plain {
+			t.Errorf("GenerateNanoIDWithCheckDigit() = %q, want prefix %q", checked, plain)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := GenerateNanoIDWithCheckDigit(Alphanumeric, 10, nil, 0, CheckDigitDamm); err == nil {
+			t.Error("GenerateNanoIDWithCheckDigit() expected error for damm with a 62 character alphabet")
+		}
+		if _, err := GenerateNanoIDWithCheckDigit(Numeric, 1, nil, 0, CheckDigitDamm); err == nil {
+			t.Error("GenerateNanoIDWithCheckDigit() expected error for length 1")
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"crypto/sha3"
+	"fmt"
+	"math/big"
+	mathrand "math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// CUID2DefaultLength is the default length of a CUID2.
+	CUID2DefaultLength = 24
+
+	// CUID2MinLength is the minimum length of a CUID2.
+	CUID2MinLength = 2
+
+	// CUID2MaxLength is the maximum length of a CUID2.
+	CUID2MaxLength = 32
+
+	// cuid2FingerprintLength is the length of the host fingerprint mixed into the hash.
+	cuid2FingerprintLength = 32
+
+	// cuid2InitialCountMax is the upper bound for the randomly initialized counter.
+	cuid2InitialCountMax = 476782367
+)
+
+// GenerateCUID2 generates a CUID2 of the given length.
+//
+// The algorithm follows the reference implementation (https://github.com/paralleldrive/cuid2):
+// a random lowercase letter followed by a base36 encoded SHA3-512 hash over the
+// timestamp, a random salt, a counter and a random fingerprint.
+//
+// If seed is non-nil, all random inputs are derived from the seed and the timestamp
+// is omitted, so the output is deterministic.
+// Otherwise, a cryptographically secure random source is used.
+func GenerateCUID2(length int, seed *int64) (string, error) {
+	if length < CUID2MinLength || length > CUID2MaxLength {
+		return "", fmt.Errorf("CUID2 length must be between %d and %d, got %d", CUID2MinLength, CUID2MaxLength, length)
+	}
+
+	var rng *mathrand.Rand
+	timestamp := ""
+
+	if seed != nil {
+		// Seeded mode: deterministic generation using math/rand
+		rng = mathrand.New(mathrand.NewPCG(uint64(*seed), uint64(*seed)))
+	} else {
+		// Unseeded mode: ChaCha8 keyed from crypto/rand
+		var key [32]byte
+		if _, err := rand.Read(key[:]); err != nil {
+			return "", err
+		}
+		rng = mathrand.New(mathrand.NewChaCha8(key))
+		timestamp = strconv.FormatInt(time.Now().UnixMilli(), 36)
+	}
+
+	firstLetter := byte('a' + rng.IntN(26))
+	salt := cuid2Entropy(rng, length)
+	count := strconv.FormatInt(int64(rng.IntN(cuid2InitialCountMax)), 36)
+	fingerprint := cuid2Hash(cuid2Entropy(rng, cuid2FingerprintLength))[:cuid2FingerprintLength]
+
+	hash := cuid2Hash(timestamp + salt + count + fingerprint)
+
+	return string(firstLetter) + hash[1:length], nil
+}
+
+// cuid2Entropy returns a random base36 string of the given length.
+func cuid2Entropy(rng *mathrand.Rand, length int) string {
+	var sb strings.Builder
+	for sb.Len() < length {
+		sb.WriteString(strconv.FormatInt(int64(rng.IntN(36)), 36))
+	}
+	return sb.String()
+}
+
+// cuid2Hash returns the SHA3-512 hash of the input as a base36 string,
+// dropping the first character which is biased by the leading bits.
+func cuid2Hash(input string) string {
+	sum := sha3.Sum512([]byte(input))
+	return new(big.Int).SetBytes(sum[:]).Text(36)[1:]
+}
@@ -0,0 +1,78 @@
+package idgen
+
+import (
+	"regexp"
+	"testing"
+)
+
+var cuid2Pattern = regexp.MustCompile(`^[a-z][0-9a-z]+$`)
+
+func TestGenerateCUID2(t *testing.T) {
+	t.Run("unseeded generation", func(t *testing.T) {
+		id, err := GenerateCUID2(CUID2DefaultLength, nil)
+		if err != nil {
+			t.Fatalf("GenerateCUID2() error = %v", err)
+		}
+
+		if len(id) != CUID2DefaultLength {
+			t.Errorf("GenerateCUID2() length = %d, want %d", len(id), CUID2DefaultLength)
+		}
+		if !cuid2Pattern.MatchString(id) {
+			t.Errorf("GenerateCUID2() = %q, want a lowercase letter followed by base36 characters", id)
+		}
+	})
+
+	t.Run("unseeded generation is unique", func(t *testing.T) {
+		seen := make(map[string]struct{})
+		for i := 0; i < 1000; i++ {
+			id, err := GenerateCUID2(CUID2DefaultLength, nil)
+			if err != nil {
+				t.Fatalf("GenerateCUID2() error = %v", err)
+			}
+			if _, ok := seen[id]; ok {
+				t.Fatalf("GenerateCUID2() produced duplicate %q", id)
+			}
+			seen[id] = struct{}{}
+		}
+	})
+
+	t.Run("seeded generation is deterministic", func(t *testing.T) {
+		seed := int64(12345)
+		id1, err1 := GenerateCUID2(CUID2DefaultLength, &seed)
+		id2, err2 := GenerateCUID2(CUID2DefaultLength, &seed)
+
+		if err1 != nil || err2 != nil {
+			t.Fatalf("GenerateCUID2() errors = %v, %v", err1, err2)
+		}
+		if id1 != id2 {
+			t.Errorf("GenerateCUID2() seeded not deterministic: %q != %q", id1, id2)
+		}
+
+		other := int64(12346)
+		id3, _ := GenerateCUID2(CUID2DefaultLength, &other)
+		if id1 == id3 {
+			t.Errorf("GenerateCUID2() different seeds produced the same ID %q", id1)
+		}
+	})
+
+	t.Run("length bounds", func(t *testing.T) {
+		for _, length := range []int{CUID2MinLength, 10, CUID2MaxLength} {
+			id, err := GenerateCUID2(length, nil)
+			if err != nil {
+				t.Fatalf("GenerateCUID2(%d) error = %v", length, err)
+			}
+			if len(id) != length {
+				t.Errorf("GenerateCUID2(%d) length = %d", length, len(id))
+			}
+			if !cuid2Pattern.MatchString(id) {
+				t.Errorf("GenerateCUID2(%d) = %q, want a lowercase letter followed by base36 characters", length, id)
+			}
+		}
+
+		for _, length := range []int{0, 1, CUID2MaxLength + 1} {
+			if _, err := GenerateCUID2(length, nil); err == nil {
+				t.Errorf("GenerateCUID2(%d) expected error, got nil", length)
+			}
+		}
+	})
+}
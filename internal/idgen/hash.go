@@ -0,0 +1,166 @@
+package idgen
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"hash/fnv"
+	"math/big"
+	"strings"
+)
+
+// Hash algorithms supported by HashDigest. They derive names from inputs and are
+// not meant for security purposes.
+const (
+	HashSHA256 = "sha256"
+	HashSHA1   = "sha1"
+	HashMD5    = "md5"
+	HashFNV64  = "fnv64"
+	HashCRC32  = "crc32"
+)
+
+// Digest encodings supported by EncodeDigest.
+const (
+	// HashEncodingHex is lowercase hexadecimal.
+	HashEncodingHex = "hex"
+
+	// HashEncodingBase32 is lowercase RFC 4648 base32 without padding.
+	HashEncodingBase32 = "base32"
+
+	// HashEncodingBase62 renders the digest through the Alphanumeric alphabet.
+	HashEncodingBase62 = "base62"
+
+	// HashEncodingReadable renders the digest through the Readable alphabet.
+	HashEncodingReadable = "readable"
+)
+
+var lowercaseBase32 = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// HashDigest returns the digest of data with the given algorithm.
+// FNV is FNV-1a, CRC32 uses the IEEE polynomial; both digests are big-endian.
+func HashDigest(algorithm string, data []byte) ([]byte, error) {
+	switch algorithm {
+	case HashSHA256:
+		sum := sha256.Sum256(data)
+		return sum[:], nil
+	case HashSHA1:
+		sum := sha1.Sum(data)
+		return sum[:], nil
+	case HashMD5:
+		sum := md5.Sum(data)
+		return sum[:], nil
+	case HashFNV64:
+		h := fnv.New64a()
+		h.Write(data)
+		return h.Sum(nil), nil
+	case HashCRC32:
+		h := crc32.NewIEEE()
+		h.Write(data)
+		return h.Sum(nil), nil
+	default:
+		return nil, fmt.Errorf("unknown hash algorithm %q, valid algorithms are: sha256, sha1, md5, fnv64, crc32", algorithm)
+	}
+}
+
+// EncodeDigest encodes a digest as text. The base62 and readable encodings are
+// fixed-width: every digest of the same size encodes to the same length.
+func EncodeDigest(digest []byte, encoding string) (string, error) {
+	switch encoding {
+	case HashEncodingHex:
+		return hex.EncodeToString(digest), nil
+	case HashEncodingBase32:
+		return lowercaseBase32.EncodeToString(digest), nil
+	case HashEncodingBase62:
+		return encodeBase(new(big.Int).SetBytes(digest), Alphanumeric, baseWidth(len(digest)*8, len(Alphanumeric))), nil
+	case HashEncodingReadable:
+		return encodeBase(new(big.Int).SetBytes(digest), Readable, baseWidth(len(digest)*8, len(Readable))), nil
+	default:
+		return "", fmt.Errorf("unknown hash encoding %q, valid encodings are: hex, base32, base62, readable", encoding)
+	}
+}
+
+// HashTo renders the SHA-256 digest of s as length characters of alphabet.
+// The characters are the last digits of the digest in base len(alphabet),
+// so they are evenly distributed. The alphabet can hold at most 256 bits of
+// the digest, which limits length (see HashToMaxLength).
+func HashTo(s, alphabet string, length int) (string, error) {
+	if err := validateHashAlphabet(alphabet); err != nil {
+		return "", err
+	}
+	if length < 1 {
+		return "", fmt.Errorf("length must be at least 1, got %d", length)
+	}
+
+	if maxLength := HashToMaxLength(alphabet); length > maxLength {
+		return "", fmt.Errorf("length %d exceeds the 256 bits of a SHA-256 digest, at most %d characters of a %d-character alphabet fit",
+			length, maxLength, len(alphabet))
+	}
+
+	modulus := new(big.Int).Exp(big.NewInt(int64(len(alphabet))), big.NewInt(int64(length)), nil)
+	sum := sha256.Sum256([]byte(s))
+	value := new(big.Int).Mod(new(big.Int).SetBytes(sum[:]), modulus)
+	return encodeBase(value, alphabet, length), nil
+}
+
+// HashToMaxLength returns the largest length HashTo accepts for alphabet: the number
+// of its characters whose combinations do not outnumber the SHA-256 digests.
+func HashToMaxLength(alphabet string) int {
+	if len(alphabet) < 2 {
+		return 0
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), sha256.Size*8)
+	base := big.NewInt(int64(len(alphabet)))
+	power := new(big.Int).Set(base)
+	length := 0
+	for power.Cmp(limit) <= 0 {
+		power.Mul(power, base)
+		length++
+	}
+	return length
+}
+
+// validateHashAlphabet checks that alphabet can serve as the digits of a number base.
+func validateHashAlphabet(alphabet string) error {
+	if len(alphabet) < 2 {
+		return errors.New("alphabet must have at least 2 characters")
+	}
+	for i := 0; i < len(alphabet); i++ {
+		if strings.IndexByte(alphabet[i+1:], alphabet[i]) >= 0 {
+			return fmt.Errorf("alphabet contains %q more than once", alphabet[i])
+		}
+	}
+	return nil
+}
+
+// baseWidth returns the number of base-n digits needed for any value of the given bit size.
+func baseWidth(bits, n int) int {
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	power := big.NewInt(1)
+	base := big.NewInt(int64(n))
+	width := 0
+	for power.Cmp(limit) < 0 {
+		power.Mul(power, base)
+		width++
+	}
+	return width
+}
+
+// encodeBase writes value in base len(alphabet) with exactly width digits,
+// most significant first and padded with the first character of alphabet.
+func encodeBase(value *big.Int, alphabet string, width int) string {
+	result := make([]byte, width)
+	base := big.NewInt(int64(len(alphabet)))
+	rest := new(big.Int).Set(value)
+	digit := new(big.Int)
+	for i := width - 1; i >= 0; i-- {
+		rest.DivMod(rest, base, digit)
+		result[i] = alphabet[digit.Int64()]
+	}
+	return string(result)
+}
@@ -0,0 +1,122 @@
+package idgen
+
+import (
+	"strings"
+	"testing"
+)
+
+const hashTestInput = "https://github.com/iilei/terraform-provider-idgen"
+
+func TestHashDigest(t *testing.T) {
+	// Digests and encodings are part of the output contract and must never change
+	tests := []struct {
+		algorithm string
+		encoding  string
+		expected  string
+	}{
+		{HashSHA256, HashEncodingHex, "10cd0f60aa1cfb094d4cca02ae58bde26d6632b69a8894047f3c768c250fb2fe"},
+		{HashSHA256, HashEncodingBase32, "cdgq6yfkdt5qstkmzibk4wf54jwwmmvwtkejibd7hr3iyjipwl7a"},
+		{HashSHA256, HashEncodingBase62, "d9aN3cWe1Eo08jQOZEQ4ao2PRryXiGIa63armDGka7K"},
+		{HashSHA256, HashEncodingReadable, "2fns97ZA52P7pP7qZ4e9tmR2JBEYPnmFG9ZR8KHNC8FQ6L"},
+		{HashSHA1, HashEncodingHex, "9bb87bab397711d0c9ff9fc4006e66f45d6c7805"},
+		{HashMD5, HashEncodingHex, "1fe8cb0a2723b2a0d62e001ded124b92"},
+		{HashFNV64, HashEncodingHex, "1e471d55691f3cf8"},
+		{HashFNV64, HashEncodingBase62, "cLkzQNExyfA"},
+		{HashCRC32, HashEncodingHex, "dd5bc8c9"},
+		{HashCRC32, HashEncodingReadable, "dScdYf"},
+	}
+
+	for _, tt := range tests {
+		digest, err := HashDigest(tt.algorithm, []byte(hashTestInput))
+		if err != nil {
+			t.Fatalf("HashDigest(%s) error = %v", tt.algorithm, err)
+		}
+		got, err := EncodeDigest(digest, tt.encoding)
+		if err != nil {
+			t.Fatalf("EncodeDigest(%s) error = %v", tt.encoding, err)
+		}
+		if got != tt.expected {
+			t.Errorf("%s %s = %q, want %q", tt.algorithm, tt.encoding, got, tt.expected)
+		}
+	}
+
+	if _, err := HashDigest("sha512", nil); err == nil {
+		t.Error("HashDigest() expected error for unknown algorithm")
+	}
+	if _, err := EncodeDigest([]byte{1}, "base64"); err == nil {
+		t.Error("EncodeDigest() expected error for unknown encoding")
+	}
+}
+
+func TestEncodeDigestFixedWidth(t *testing.T) {
+	// Small digests are padded to the width of the largest digest of their size
+	for _, digest := range [][]byte{{0, 0, 0, 0}, {0, 0, 0, 1}, {255, 255, 255, 255}} {
+		got, err := EncodeDigest(digest, HashEncodingBase62)
+		if err != nil {
+			t.Fatalf("EncodeDigest() error = %v", err)
+		}
+		if len(got) != 6 {
+			t.Errorf("EncodeDigest(%v) = %q, want 6 characters", digest, got)
+		}
+	}
+}
+
+func TestHashTo(t *testing.T) {
+	tests := []struct {
+		alphabet string
+		length   int
+		expected string
+	}{
+		{Readable, 8, "HNC8FQ6L"},
+		{Numeric, 6, "730238"},
+		{"abc", 6, "abbcbc"},
+	}
+
+	for _, tt := range tests {
+		got, err := HashTo(hashTestInput, tt.alphabet, tt.length)
+		if err != nil {
+			t.Fatalf("HashTo(%q, %d) error = %v", tt.alphabet, tt.length, err)
+		}
+		if got != tt.expected {
+			t.Errorf("HashTo(%q, %d) = %q, want %q", tt.alphabet, tt.length, got, tt.expected)
+		}
+	}
+
+	// The last characters of a longer rendering match a shorter one
+	long, _ := HashTo(hashTestInput, Readable, 45)
+	if !strings.HasSuffix(long, "HNC8FQ6L") {
+		t.Errorf("HashTo(readable, 45) = %q, want suffix HNC8FQ6L", long)
+	}
+
+	errorCases := []struct {
+		alphabet string
+		length   int
+		contains string
+	}{
+		{"a", 4, "at least 2 characters"},
+		{"aab", 4, "more than once"},
+		{Numeric, 0, "at least 1"},
+		{Alphanumeric, 43, "at most 42 characters"},
+	}
+	for _, tt := range errorCases {
+		_, err := HashTo(hashTestInput, tt.alphabet, tt.length)
+		if err == nil || !strings.Contains(err.Error(), tt.contains) {
+			t.Errorf("HashTo(%q, %d) error = %v, want error containing %q", tt.alphabet, tt.length, err, tt.contains)
+		}
+	}
+}
+
+func TestHashToMaxLength(t *testing.T) {
+	tests := map[string]int{
+		"01":         256,
+		Numeric:      77,
+		Alphanumeric: 42,
+		Readable:     45,
+		"a":          0,
+	}
+	for alphabet, expected := range tests {
+		if got := HashToMaxLength(alphabet); got != expected {
+			t.Errorf("HashToMaxLength(%q) = %d, want %d", alphabet, got, expected)
+		}
+	}
+}
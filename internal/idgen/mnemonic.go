@@ -0,0 +1,142 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/iilei/terraform-provider-idgen/internal/data"
+)
+
+const (
+	// MnemonicMinEntropyBits is the minimum entropy size for a BIP39 mnemonic (12 words).
+	MnemonicMinEntropyBits = 128
+
+	// MnemonicMaxEntropyBits is the maximum entropy size for a BIP39 mnemonic (24 words).
+	MnemonicMaxEntropyBits = 256
+
+	// mnemonicBitsPerWord is the number of bits encoded by a single BIP39 word.
+	mnemonicBitsPerWord = 11
+)
+
+// bip39WordIndex maps each BIP39 word to its 11-bit value.
+var bip39WordIndex map[string]int
+
+func init() {
+	bip39WordIndex = make(map[string]int, len(data.BIP39English))
+	for i, word := range data.BIP39English {
+		bip39WordIndex[word] = i
+	}
+}
+
+// ValidateMnemonicEntropyBits checks that the entropy size is one of the sizes
+// allowed by BIP39: a multiple of 32 between 128 and 256 bits.
+func ValidateMnemonicEntropyBits(bits int) error {
+	if bits < MnemonicMinEntropyBits || bits > MnemonicMaxEntropyBits || bits%32 != 0 {
+		return fmt.Errorf("entropy must be 128, 160, 192, 224 or 256 bits, got %d", bits)
+	}
+	return nil
+}
+
+// GenerateMnemonic generates a BIP39 mnemonic encoding entropyBits of entropy.
+// The words are joined by separator; the last word contains the checksum.
+// If seed is non-nil, the entropy is generated deterministically from the seed.
+// Otherwise, crypto/rand is used.
+func GenerateMnemonic(entropyBits int, seed *int64, separator string) (string, error) {
+	if err := ValidateMnemonicEntropyBits(entropyBits); err != nil {
+		return "", err
+	}
+
+	var entropy []byte
+	if seed != nil {
+		entropy = generateSeededBytes(*seed, entropyBits/8)
+	} else {
+		entropy = make([]byte, entropyBits/8)
+		if _, err := rand.Read(entropy); err != nil {
+			return "", err
+		}
+	}
+
+	words, err := EncodeMnemonic(entropy)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(words, separator), nil
+}
+
+// EncodeMnemonic encodes entropy as BIP39 words. The first len(entropy)/4 bits of
+// the SHA-256 hash of the entropy are appended as checksum before splitting the
+// bits into 11-bit word indices.
+func EncodeMnemonic(entropy []byte) ([]string, error) {
+	entropyBits := len(entropy) * 8
+	if err := ValidateMnemonicEntropyBits(entropyBits); err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(entropy)
+	bits := append(append([]byte{}, entropy...), checksum[0])
+	wordCount := (entropyBits + entropyBits/32) / mnemonicBitsPerWord
+
+	words := make([]string, wordCount)
+	for i := range words {
+		index := 0
+		for b := 0; b < mnemonicBitsPerWord; b++ {
+			bit := i*mnemonicBitsPerWord + b
+			index <<= 1
+			if bits[bit/8]&(0x80>>(bit%8)) != 0 {
+				index |= 1
+			}
+		}
+		words[i] = data.BIP39English[index]
+	}
+
+	return words, nil
+}
+
+// SplitMnemonic returns the lowercase words of a mnemonic. Any character other than
+// a letter separates words.
+func SplitMnemonic(mnemonic string) []string {
+	return strings.FieldsFunc(strings.ToLower(mnemonic), func(r rune) bool {
+		return r < 'a' || r > 'z'
+	})
+}
+
+// DecodeMnemonic verifies a BIP39 mnemonic and returns its entropy as lowercase hex.
+// Words may be separated by whitespace, dashes, underscores, dots or commas, and are
+// matched case-insensitively.
+func DecodeMnemonic(mnemonic string) (string, error) {
+	words := SplitMnemonic(mnemonic)
+
+	totalBits := len(words) * mnemonicBitsPerWord
+	entropyBits := totalBits * 32 / 33
+	if len(words)%3 != 0 || ValidateMnemonicEntropyBits(entropyBits) != nil {
+		return "", fmt.Errorf("mnemonic must have 12, 15, 18, 21 or 24 words, got %d", len(words))
+	}
+
+	bits := make([]byte, (totalBits+7)/8)
+	for i, word := range words {
+		index, ok := bip39WordIndex[word]
+		if !ok {
+			return "", fmt.Errorf("word %d (%q) is not in the BIP39 English word list", i+1, word)
+		}
+		for b := 0; b < mnemonicBitsPerWord; b++ {
+			if index&(1<<(mnemonicBitsPerWord-1-b)) != 0 {
+				bit := i*mnemonicBitsPerWord + b
+				bits[bit/8] |= 0x80 >> (bit % 8)
+			}
+		}
+	}
+
+	entropy := bits[:entropyBits/8]
+	checksumBits := entropyBits / 32
+	checksum := sha256.Sum256(entropy)
+	mask := byte(0xff << (8 - checksumBits))
+	if bits[entropyBits/8]&mask != checksum[0]&mask {
+		return "", fmt.Errorf("mnemonic checksum mismatch (last word %q does not match the preceding words)", words[len(words)-1])
+	}
+
+	return hex.EncodeToString(entropy), nil
+}
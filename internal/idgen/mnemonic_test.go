@@ -0,0 +1,145 @@
+package idgen
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// TestMnemonicVectors tests encoding and decoding against the reference BIP39
+// test vectors: https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+func TestMnemonicVectors(t *testing.T) {
+	tests := []struct {
+		entropy  string
+		mnemonic string
+	}{
+		{
+			"00000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		},
+		{
+			"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		},
+		{
+			"80808080808080808080808080808080",
+			"letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+		},
+		{
+			"ffffffffffffffffffffffffffffffff",
+			"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+		},
+		{
+			"9e885d952ad362caeb4efe34a8e91bd2",
+			"ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic",
+		},
+		{
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon " +
+				"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+		},
+		{
+			"68a79eaca2324873eacc50cb9c6eca8cc68ea5d936f98787c60c7ebc74e6ce7c",
+			"hamster diagram private dutch cause delay private meat slide toddler razor book happy fancy gospel " +
+				"tennis maple dilemma loan word shrug inflict delay length",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.entropy, func(t *testing.T) {
+			entropy, _ := hex.DecodeString(tt.entropy)
+
+			words, err := EncodeMnemonic(entropy)
+			if err != nil {
+				t.Fatalf("EncodeMnemonic() error = %v", err)
+			}
+			if got := strings.Join(words, " "); got != tt.mnemonic {
+				t.Errorf("EncodeMnemonic(%s) = %q, want %q", tt.entropy, got, tt.mnemonic)
+			}
+
+			decoded, err := DecodeMnemonic(tt.mnemonic)
+			if err != nil {
+				t.Fatalf("DecodeMnemonic() error = %v", err)
+			}
+			if decoded != tt.entropy {
+				t.Errorf("DecodeMnemonic(%q) = %s, want %s", tt.mnemonic, decoded, tt.entropy)
+			}
+		})
+	}
+}
+
+func TestGenerateMnemonic(t *testing.T) {
+	t.Run("word count per entropy size", func(t *testing.T) {
+		for bits, wantWords := range map[int]int{128: 12, 160: 15, 192: 18, 224: 21, 256: 24} {
+			mnemonic, err := GenerateMnemonic(bits, nil, "-")
+			if err != nil {
+				t.Fatalf("GenerateMnemonic(%d) error = %v", bits, err)
+			}
+			if n := len(strings.Split(mnemonic, "-")); n != wantWords {
+				t.Errorf("GenerateMnemonic(%d) has %d words, want %d", bits, n, wantWords)
+			}
+			if _, err := DecodeMnemonic(mnemonic); err != nil {
+				t.Errorf("DecodeMnemonic(%q) error = %v", mnemonic, err)
+			}
+		}
+	})
+
+	t.Run("seeded generation is deterministic", func(t *testing.T) {
+		seed := int64(12345)
+		m1, _ := GenerateMnemonic(128, &seed, " ")
+		m2, _ := GenerateMnemonic(128, &seed, " ")
+		if m1 != m2 {
+			t.Errorf("GenerateMnemonic() seeded not deterministic: %q != %q", m1, m2)
+		}
+	})
+
+	t.Run("invalid entropy size", func(t *testing.T) {
+		for _, bits := range []int{0, 96, 129, 288} {
+			if _, err := GenerateMnemonic(bits, nil, " "); err == nil {
+				t.Errorf("GenerateMnemonic(%d) expected error, got nil", bits)
+			}
+		}
+	})
+}
+
+func TestSplitMnemonic(t *testing.T) {
+	words := SplitMnemonic(" Legal-Winner_thank.YEAR, wave ")
+	if strings.Join(words, " ") != "legal winner thank year wave" {
+		t.Errorf("SplitMnemonic() = %q, want [legal winner thank year wave]", words)
+	}
+}
+
+func TestDecodeMnemonic(t *testing.T) {
+	t.Run("tolerates separators and case", func(t *testing.T) {
+		entropy, err := DecodeMnemonic("Legal-Winner-Thank-Year-Wave-Sausage_Worth.Useful, legal  winner thank yellow")
+		if err != nil {
+			t.Fatalf("DecodeMnemonic() error = %v", err)
+		}
+		if entropy != "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f" {
+			t.Errorf("DecodeMnemonic() = %s, want 7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f", entropy)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		mnemonic string
+		errPart  string
+	}{
+		{"checksum mismatch", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon", "checksum"},
+		{"unknown word", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon zzzzz", "word 12"},
+		{"wrong word count", "abandon abandon about", "12, 15, 18, 21 or 24 words"},
+		{"empty", "", "12, 15, 18, 21 or 24 words"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeMnemonic(tt.mnemonic)
+			if err == nil {
+				t.Fatalf("DecodeMnemonic(%q) expected error, got nil", tt.mnemonic)
+			}
+			if !strings.Contains(err.Error(), tt.errPart) {
+				t.Errorf("DecodeMnemonic(%q) error = %q, want it to mention %q", tt.mnemonic, err.Error(), tt.errPart)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package idgen
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+const (
+	// NamingProfileS3Bucket covers AWS S3 bucket names (general purpose buckets).
+	NamingProfileS3Bucket = "s3_bucket"
+
+	// NamingProfileAzureStorageAccount covers Azure storage account names.
+	NamingProfileAzureStorageAccount = "azure_storage_account"
+
+	// NamingProfileGCPProjectID covers Google Cloud project IDs.
+	NamingProfileGCPProjectID = "gcp_project_id"
+
+	// NamingProfileK8sDNSLabel covers Kubernetes names that must be RFC 1123 DNS labels
+	// (e.g., namespaces and services).
+	NamingProfileK8sDNSLabel = "k8s_dns_label"
+)
+
+const (
+	lowercaseLetters      = "abcdefghijklmnopqrstuvwxyz"
+	lowercaseAlphanumeric = lowercaseLetters + "0123456789"
+)
+
+// NamingProfile describes the naming rules of a cloud resource type.
+type NamingProfile struct {
+	MinLength int
+	MaxLength int
+
+	// Allowed holds the characters allowed anywhere in the name, First and Last
+	// the characters allowed at the start and the end.
+	Allowed string
+	First   string
+	Last    string
+
+	// Human-readable descriptions of Allowed, First and Last for error messages.
+	AllowedDescription string
+	FirstDescription   string
+	LastDescription    string
+
+	ForbiddenSubstrings []string
+	ForbiddenPrefixes   []string
+	ForbiddenSuffixes   []string
+
+	// NoIPAddress forbids names formatted as an IPv4 address (e.g., "192.168.5.4").
+	NoIPAddress bool
+}
+
+// NamingProfiles holds the supported naming profiles by name.
+// See:
+//   - https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucketnamingrules.html
+//   - https://learn.microsoft.com/en-us/azure/azure-resource-manager/management/resource-name-rules
+//   - https://cloud.google.com/resource-manager/docs/creating-managing-projects
+//   - https://kubernetes.io/docs/concepts/overview/working-with-objects/names/
+var NamingProfiles = map[string]NamingProfile{
+	NamingProfileS3Bucket: {
+		MinLength:           3,
+		MaxLength:           63,
+		Allowed:             lowercaseAlphanumeric + ".-",
+		First:               lowercaseAlphanumeric,
+		Last:                lowercaseAlphanumeric,
+		AllowedDescription:  "lowercase letters, digits, '.' and '-'",
+		FirstDescription:    "a lowercase letter or digit",
+		LastDescription:     "a lowercase letter or digit",
+		ForbiddenSubstrings: []string{".."},
+		ForbiddenPrefixes:   []string{"xn--", "sthree-", "amzn-s3-demo-"},
+		ForbiddenSuffixes:   []string{"-s3alias", "--ol-s3", "--x-s3", "--table-s3"},
+		NoIPAddress:         true,
+	},
+	NamingProfileAzureStorageAccount: {
+		MinLength:          3,
+		MaxLength:          24,
+		Allowed:            lowercaseAlphanumeric,
+		First:              lowercaseAlphanumeric,
+		Last:               lowercaseAlphanumeric,
+		AllowedDescription: "lowercase letters and digits",
+		FirstDescription:   "a lowercase letter or digit",
+		LastDescription:    "a lowercase letter or digit",
+	},
+	NamingProfileGCPProjectID: {
+		MinLength:          6,
+		MaxLength:          30,
+		Allowed:            lowercaseAlphanumeric + "-",
+		First:              lowercaseLetters,
+		Last:               lowercaseAlphanumeric,
+		AllowedDescription: "lowercase letters, digits and '-'",
+		FirstDescription:   "a lowercase letter",
+		LastDescription:    "a lowercase letter or digit",
+	},
+	NamingProfileK8sDNSLabel: {
+		MinLength:          1,
+		MaxLength:          63,
+		Allowed:            lowercaseAlphanumeric + "-",
+		First:              lowercaseAlphanumeric,
+		Last:               lowercaseAlphanumeric,
+		AllowedDescription: "lowercase letters, digits and '-'",
+		FirstDescription:   "a lowercase letter or digit",
+		LastDescription:    "a lowercase letter or digit",
+	},
+}
+
+// NamingViolation describes a single violation of a naming profile.
+type NamingViolation struct {
+	// Position is the byte offset of the offending character, or -1 if the
+	// violation concerns the name as a whole (e.g., its length).
+	Position int
+	Message  string
+}
+
+// Separator returns "-" if the profile allows dashes and "" otherwise, e.g. to join
+// the parts of a generated name.
+func (p NamingProfile) Separator() string {
+	if strings.IndexByte(p.Allowed, '-') >= 0 {
+		return "-"
+	}
+	return ""
+}
+
+// GetNamingProfile returns the naming profile with the given name.
+func GetNamingProfile(name string) (NamingProfile, error) {
+	profile, ok := NamingProfiles[name]
+	if !ok {
+		names := make([]string, 0, len(NamingProfiles))
+		for n := range NamingProfiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return NamingProfile{}, fmt.Errorf("unknown naming profile %q, valid profiles are: %s", name, strings.Join(names, ", "))
+	}
+	return profile, nil
+}
+
+// Validate returns all violations of the profile by name, in order of position.
+// Violations concerning the whole name come first.
+func (p NamingProfile) Validate(name string) []NamingViolation {
+	var violations []NamingViolation
+
+	if len(name) < p.MinLength {
+		violations = append(violations, NamingViolation{-1, fmt.Sprintf("name is %d characters long, the minimum is %d", len(name), p.MinLength)})
+	}
+	if len(name) > p.MaxLength {
+		violations = append(violations, NamingViolation{-1, fmt.Sprintf("name is %d characters long, the maximum is %d", len(name), p.MaxLength)})
+	}
+	if p.NoIPAddress {
+		if ip := net.ParseIP(name); ip != nil && ip.To4() != nil {
+			violations = append(violations, NamingViolation{-1, "name must not be formatted as an IP address"})
+		}
+	}
+	for _, prefix := range p.ForbiddenPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			violations = append(violations, NamingViolation{0, fmt.Sprintf("name must not start with %q", prefix)})
+		}
+	}
+
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if strings.IndexByte(p.Allowed, c) < 0 {
+			violations = append(violations, NamingViolation{i, fmt.Sprintf("character %d (%q) is not allowed, only %s are", i+1, c, p.AllowedDescription)})
+			continue
+		}
+		if i == 0 && strings.IndexByte(p.First, c) < 0 {
+			violations = append(violations, NamingViolation{i, fmt.Sprintf("name must start with %s, not %q", p.FirstDescription, c)})
+		}
+		if i == len(name)-1 && strings.IndexByte(p.Last, c) < 0 {
+			violations = append(violations, NamingViolation{i, fmt.Sprintf("name must end with %s, not %q", p.LastDescription, c)})
+		}
+		for _, forbidden := range p.ForbiddenSubstrings {
+			if strings.HasPrefix(name[i:], forbidden) {
+				violations = append(violations, NamingViolation{i, fmt.Sprintf("name must not contain %q (at character %d)", forbidden, i+1)})
+			}
+		}
+	}
+
+	for _, suffix := range p.ForbiddenSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			violations = append(violations, NamingViolation{len(name) - len(suffix), fmt.Sprintf("name must not end with %q", suffix)})
+		}
+	}
+
+	return violations
+}
+
+// Adapt rewrites name to follow the character rules of the profile:
+// uppercase letters are lowercased, separators such as '_', ' ', '.', '/' and ':'
+// become '-' where allowed, other illegal characters are removed, and leading and
+// trailing characters not allowed at the start or end are stripped.
+// Length limits are not enforced, so the result can still violate the profile.
+func (p NamingProfile) Adapt(name string) string {
+	name = strings.ToLower(name)
+
+	var adapted strings.Builder
+	replaced := false
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case strings.IndexByte(p.Allowed, c) >= 0:
+			adapted.WriteByte(c)
+			replaced = false
+		case strings.IndexByte("_ ./:-", c) >= 0 && strings.IndexByte(p.Allowed, '-') >= 0:
+			// Collapse runs of replaced separators into a single dash
+			if !replaced {
+				adapted.WriteByte('-')
+			}
+			replaced = true
+		}
+	}
+	result := adapted.String()
+
+	// Shorten forbidden runs of a character, e.g. ".." becomes "."
+	for _, forbidden := range p.ForbiddenSubstrings {
+		for strings.Contains(result, forbidden) {
+			result = strings.ReplaceAll(result, forbidden, forbidden[:len(forbidden)-1])
+		}
+	}
+
+	result = strings.TrimLeftFunc(result, func(r rune) bool { return !strings.ContainsRune(p.First, r) })
+	result = strings.TrimRightFunc(result, func(r rune) bool { return !strings.ContainsRune(p.Last, r) })
+
+	return result
+}
@@ -0,0 +1,101 @@
+package idgen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNamingProfileValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		profile   string
+		input     string
+		positions []int
+		errPart   string
+	}{
+		{"valid s3 bucket", NamingProfileS3Bucket, "logs.example-01", nil, ""},
+		{"s3 bucket too short", NamingProfileS3Bucket, "ab", []int{-1}, "minimum is 3"},
+		{"s3 bucket with underscore", NamingProfileS3Bucket, "my_bucket", []int{2}, `character 3 ('_') is not allowed`},
+		{"s3 bucket with uppercase", NamingProfileS3Bucket, "myBucket", []int{2}, `character 3 ('B')`},
+		{"s3 bucket with adjacent periods", NamingProfileS3Bucket, "my..bucket", []int{2}, `must not contain ".."`},
+		{"s3 bucket formatted as IP address", NamingProfileS3Bucket, "192.168.5.4", []int{-1}, "IP address"},
+		{"s3 bucket with reserved prefix", NamingProfileS3Bucket, "xn--bucket", []int{0}, `must not start with "xn--"`},
+		{"s3 bucket with reserved suffix", NamingProfileS3Bucket, "bucket-s3alias", []int{6}, `must not end with "-s3alias"`},
+		{"s3 bucket ending with dash", NamingProfileS3Bucket, "bucket-", []int{6}, "must end with a lowercase letter or digit"},
+		{"valid azure storage account", NamingProfileAzureStorageAccount, "stlogs01", nil, ""},
+		{"azure storage account with dash", NamingProfileAzureStorageAccount, "st-logs", []int{2}, "only lowercase letters and digits"},
+		{"azure storage account too long", NamingProfileAzureStorageAccount, strings.Repeat("a", 25), []int{-1}, "maximum is 24"},
+		{"valid gcp project id", NamingProfileGCPProjectID, "my-project-42", nil, ""},
+		{"gcp project id starting with digit", NamingProfileGCPProjectID, "42-project", []int{0}, "must start with a lowercase letter"},
+		{"valid k8s dns label", NamingProfileK8sDNSLabel, "a", nil, ""},
+		{"k8s dns label starting with dash", NamingProfileK8sDNSLabel, "-web", []int{0}, "must start with a lowercase letter or digit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, err := GetNamingProfile(tt.profile)
+			if err != nil {
+				t.Fatalf("GetNamingProfile() error = %v", err)
+			}
+
+			violations := profile.Validate(tt.input)
+			if len(violations) != len(tt.positions) {
+				t.Fatalf("Validate(%q) = %v, want %d violations", tt.input, violations, len(tt.positions))
+			}
+			for i, v := range violations {
+				if v.Position != tt.positions[i] {
+					t.Errorf("Validate(%q) violation %d position = %d, want %d", tt.input, i, v.Position, tt.positions[i])
+				}
+			}
+			if tt.errPart != "" && !strings.Contains(violations[0].Message, tt.errPart) {
+				t.Errorf("Validate(%q) message = %q, want it to contain %q", tt.input, violations[0].Message, tt.errPart)
+			}
+		})
+	}
+}
+
+func TestNamingProfileAdapt(t *testing.T) {
+	tests := []struct {
+		profile  string
+		input    string
+		expected string
+	}{
+		{NamingProfileS3Bucket, "My_Bucket..Logs", "my-bucket.logs"},
+		{NamingProfileS3Bucket, "-logs-", "logs"},
+		{NamingProfileAzureStorageAccount, "st-Logs_01", "stlogs01"},
+		{NamingProfileGCPProjectID, "42_My Project", "my-project"},
+		{NamingProfileK8sDNSLabel, "order_01h3s6spg0fj3vpm54wak4xnpp", "order-01h3s6spg0fj3vpm54wak4xnpp"},
+		{NamingProfileK8sDNSLabel, "a__b", "a-b"},
+	}
+
+	for _, tt := range tests {
+		profile, _ := GetNamingProfile(tt.profile)
+		adapted := profile.Adapt(tt.input)
+		if adapted != tt.expected {
+			t.Errorf("Adapt(%s, %q) = %q, want %q", tt.profile, tt.input, adapted, tt.expected)
+		}
+		if violations := profile.Validate(adapted); len(violations) > 0 {
+			t.Errorf("Validate(Adapt(%s, %q)) = %v, want no violations", tt.profile, tt.input, violations)
+		}
+	}
+}
+
+func TestNamingProfileSeparator(t *testing.T) {
+	for name, expected := range map[string]string{
+		NamingProfileS3Bucket:            "-",
+		NamingProfileAzureStorageAccount: "",
+		NamingProfileK8sDNSLabel:         "-",
+	} {
+		profile, _ := GetNamingProfile(name)
+		if got := profile.Separator(); got != expected {
+			t.Errorf("Separator(%s) = %q, want %q", name, got, expected)
+		}
+	}
+}
+
+func TestGetNamingProfile(t *testing.T) {
+	_, err := GetNamingProfile("aws_lambda")
+	if err == nil || !strings.Contains(err.Error(), "azure_storage_account, gcp_project_id, k8s_dns_label, s3_bucket") {
+		t.Errorf("GetNamingProfile() error = %v, want list of valid profiles", err)
+	}
+}
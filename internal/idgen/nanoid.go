@@ -1,9 +1,12 @@
 package idgen
 
 import (
+	"errors"
+	"fmt"
 	"math"
 	"math/rand/v2"
 	"strings"
+	"unicode/utf8"
 
 	gonanoid "github.com/matoous/go-nanoid/v2"
 )
@@ -25,26 +28,53 @@ var (
 // If seed is non-nil, it generates a deterministic (seeded) ID.
 // Otherwise, it uses crypto/rand for secure random generation.
 func GenerateNanoID(alphabet string, length int, seed *int64, groupSize int) (string, error) {
+	return GenerateNanoIDWithCheckDigit(alphabet, length, seed, groupSize, "")
+}
+
+// GenerateNanoIDWithCheckDigit works like GenerateNanoID, but if checkDigit names an
+// algorithm (CheckDigitLuhnModN, CheckDigitDamm or CheckDigitVerhoeff), the last
+// character is a check character computed over the alphabet. The check character is
+// part of length and grouping is applied after it, so length remains the total visible length.
+func GenerateNanoIDWithCheckDigit(alphabet string, length int, seed *int64, groupSize int, checkDigit string) (string, error) {
 	// Calculate internal length if grouping is enabled
 	internalLength := length
 	if groupSize > 0 {
 		internalLength = int(math.Ceil(float64(length*groupSize+1) / float64(groupSize+1)))
 	}
 
+	payloadLength := internalLength
+	if checkDigit != "" {
+		if err := ValidateCheckDigitAlgorithm(checkDigit, alphabet); err != nil {
+			return "", err
+		}
+		if internalLength < 2 {
+			return "", fmt.Errorf("length must leave room for at least one character besides the check character, got %d", length)
+		}
+		payloadLength--
+	}
+
 	var id string
 	var err error
 
 	if seed != nil {
 		// Seeded mode: deterministic generation using math/rand
-		id = generateSeededNanoID(*seed, alphabet, internalLength)
+		id = generateSeededNanoID(*seed, alphabet, payloadLength)
 	} else {
 		// Unseeded mode: use go-nanoid with crypto/rand
-		id, err = gonanoid.Generate(alphabet, internalLength)
+		id, err = gonanoid.Generate(alphabet, payloadLength)
 		if err != nil {
 			return "", err
 		}
 	}
 
+	if checkDigit != "" {
+		check, err := ComputeCheckCharacter(id, alphabet, checkDigit)
+		if err != nil {
+			return "", err
+		}
+		id += string(check)
+	}
+
 	// Apply grouping if requested
 	if groupSize > 0 {
 		id = ApplyGrouping(id, groupSize)
@@ -75,14 +105,54 @@ func ApplyGrouping(id string, groupSize int) string {
 // generateSeededNanoID creates a deterministic NanoID using a seed.
 // This is NOT cryptographically secure and should only be used for
 // testing or reproducible infrastructure patterns.
+// The alphabet is indexed by character, so multi-byte characters are never split.
 func generateSeededNanoID(seed int64, alphabet string, length int) string {
 	rng := rand.New(rand.NewPCG(uint64(seed), uint64(seed)))
-	result := make([]byte, length)
-	alphabetLen := len(alphabet)
+	symbols := []rune(alphabet)
+	result := make([]rune, length)
 
 	for i := 0; i < length; i++ {
-		result[i] = alphabet[rng.IntN(alphabetLen)]
+		result[i] = symbols[rng.IntN(len(symbols))]
 	}
 
 	return string(result)
 }
+
+// ValidateNanoID checks that id could have been generated by GenerateNanoIDWithCheckDigit
+// with the given alphabet, total length, group size and check digit algorithm, and returns
+// its dash-separated groups. A length of 0 accepts any length, an empty checkDigit skips
+// check character verification.
+func ValidateNanoID(id, alphabet string, length, groupSize int, checkDigit string) ([]string, error) {
+	if id == "" {
+		return nil, errors.New("NanoID must not be empty")
+	}
+	if n := utf8.RuneCountInString(id); length > 0 && n != length {
+		return nil, fmt.Errorf("expected %d characters, got %d", length, n)
+	}
+
+	groups := []string{id}
+	if groupSize > 0 && !strings.Contains(alphabet, "-") {
+		ungrouped := strings.ReplaceAll(id, "-", "")
+		if expected := ApplyGrouping(ungrouped, groupSize); id != expected {
+			return nil, fmt.Errorf("expected groups of %d characters separated by dashes (%q), got %q", groupSize, expected, id)
+		}
+		groups = strings.Split(id, "-")
+	}
+
+	for i, c := range []rune(id) {
+		if c == '-' && len(groups) > 1 {
+			continue
+		}
+		if !strings.ContainsRune(alphabet, c) {
+			return nil, fmt.Errorf("character %d (%q) is not in the alphabet", i+1, c)
+		}
+	}
+
+	if checkDigit != "" {
+		if err := ValidateCheckCharacter(id, alphabet, checkDigit); err != nil {
+			return nil, err
+		}
+	}
+
+	return groups, nil
+}
@@ -251,3 +251,54 @@ func TestGenerateNanoID_ErrorCases(t *testing.T) {
 		GenerateNanoID("", 21, &seed, 0)
 	})
 }
+
+func TestValidateNanoID(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		seed := int64(42)
+		id, _ := GenerateNanoIDWithCheckDigit(Readable, 14, &seed, 4, CheckDigitLuhnModN)
+
+		groups, err := ValidateNanoID(id, Readable, 14, 4, CheckDigitLuhnModN)
+		if err != nil {
+			t.Fatalf("ValidateNanoID(%q) error = %v", id, err)
+		}
+		if strings.Join(groups, "-") != id || len(groups) != 3 {
+			t.Errorf("ValidateNanoID(%q) groups = %v", id, groups)
+		}
+
+		// Length and grouping are optional
+		if _, err := ValidateNanoID("abc-def", "abcdef-", 0, 0, ""); err != nil {
+			t.Errorf("ValidateNanoID() error = %v", err)
+		}
+
+		// Lengths are counted in characters, not bytes
+		if _, err := ValidateNanoID("αβγδ", "αβγδ", 4, 0, ""); err != nil {
+			t.Errorf("ValidateNanoID() error = %v", err)
+		}
+	})
+
+	errorTests := []struct {
+		name      string
+		id        string
+		length    int
+		groupSize int
+		errPart   string
+	}{
+		{"empty", "", 0, 0, "must not be empty"},
+		{"wrong length", "abcd", 5, 0, "expected 5 characters, got 4"},
+		{"wrong grouping", "ab-cdef", 0, 3, "expected groups of 3"},
+		{"missing grouping", "abcdef", 0, 3, "expected groups of 3"},
+		{"character not in alphabet", "abxd", 0, 0, "character 3 ('x')"},
+		{"dash without grouping", "ab-d", 0, 0, "character 3 ('-')"},
+		{"wrong length non-ascii", "αβγ", 6, 0, "expected 6 characters, got 3"},
+		{"character after non-ascii not in alphabet", "αβx", 0, 0, "character 3 ('x')"},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ValidateNanoID(tt.id, "abcdefαβγ", tt.length, tt.groupSize, "")
+			if err == nil || !strings.Contains(err.Error(), tt.errPart) {
+				t.Errorf("ValidateNanoID(%q) error = %v, want it to contain %q", tt.id, err, tt.errPart)
+			}
+		})
+	}
+}
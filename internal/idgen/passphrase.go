@@ -0,0 +1,89 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	mathrand "math/rand/v2"
+	"strings"
+)
+
+const (
+	// PassphraseCaseLower keeps words lowercase, e.g. "correct-horse-battery".
+	PassphraseCaseLower = "lower"
+
+	// PassphraseCaseUpper converts words to uppercase, e.g. "CORRECT-HORSE-BATTERY".
+	PassphraseCaseUpper = "upper"
+
+	// PassphraseCaseTitle capitalizes the first letter of each word, e.g. "Correct-Horse-Battery".
+	PassphraseCaseTitle = "title"
+)
+
+// GeneratePassphrase picks wordCount words from wordlist, each independently and
+// uniformly at random (words may repeat), and applies the requested case.
+// If seed is non-nil, the selection is deterministic.
+// Otherwise, crypto/rand is used.
+func GeneratePassphrase(wordlist []string, wordCount int, wordCase string, seed *int64) ([]string, error) {
+	if len(wordlist) == 0 {
+		return nil, errors.New("word list must not be empty")
+	}
+	if wordCount < 1 {
+		return nil, fmt.Errorf("word count must be at least 1, got %d", wordCount)
+	}
+
+	var rng *mathrand.Rand
+	if seed != nil {
+		rng = mathrand.New(mathrand.NewPCG(uint64(*seed), uint64(*seed)))
+	}
+
+	words := make([]string, wordCount)
+	for i := range words {
+		var index int
+		if rng != nil {
+			index = rng.IntN(len(wordlist))
+		} else {
+			n, err := rand.Int(rand.Reader, big.NewInt(int64(len(wordlist))))
+			if err != nil {
+				return nil, err
+			}
+			index = int(n.Int64())
+		}
+
+		word, err := applyWordCase(wordlist[index], wordCase)
+		if err != nil {
+			return nil, err
+		}
+		words[i] = word
+	}
+
+	return words, nil
+}
+
+// PassphraseEntropyBits returns the entropy in bits of a passphrase of wordCount
+// words chosen uniformly from a list of wordlistSize words.
+// This is the entropy of unseeded generation; a seeded passphrase is only as
+// unpredictable as its seed.
+func PassphraseEntropyBits(wordlistSize, wordCount int) float64 {
+	if wordlistSize < 1 || wordCount < 1 {
+		return 0
+	}
+	return float64(wordCount) * math.Log2(float64(wordlistSize))
+}
+
+func applyWordCase(word, wordCase string) (string, error) {
+	switch wordCase {
+	case "", PassphraseCaseLower:
+		return strings.ToLower(word), nil
+	case PassphraseCaseUpper:
+		return strings.ToUpper(word), nil
+	case PassphraseCaseTitle:
+		if word == "" {
+			return word, nil
+		}
+		return strings.ToUpper(word[:1]) + strings.ToLower(word[1:]), nil
+	default:
+		return "", fmt.Errorf("unsupported case %q (expected %q, %q or %q)", wordCase, PassphraseCaseLower, PassphraseCaseUpper, PassphraseCaseTitle)
+	}
+}
@@ -0,0 +1,96 @@
+package idgen
+
+import (
+	"math"
+	"testing"
+
+	"github.com/iilei/terraform-provider-idgen/internal/data"
+)
+
+func TestGeneratePassphrase(t *testing.T) {
+	t.Run("unseeded generation", func(t *testing.T) {
+		words, err := GeneratePassphrase(data.EFFLargeWords, 6, PassphraseCaseLower, nil)
+		if err != nil {
+			t.Fatalf("GeneratePassphrase() error = %v", err)
+		}
+		if len(words) != 6 {
+			t.Errorf("GeneratePassphrase() returned %d words, want 6", len(words))
+		}
+
+		known := make(map[string]bool)
+		for _, word := range data.EFFLargeWords {
+			known[word] = true
+		}
+		for _, word := range words {
+			if !known[word] {
+				t.Errorf("GeneratePassphrase() returned %q which is not in the word list", word)
+			}
+		}
+	})
+
+	t.Run("seeded generation is deterministic", func(t *testing.T) {
+		seed := int64(12345)
+		w1, _ := GeneratePassphrase(data.EFFShortWords, 4, PassphraseCaseLower, &seed)
+		w2, _ := GeneratePassphrase(data.EFFShortWords, 4, PassphraseCaseLower, &seed)
+
+		for i := range w1 {
+			if w1[i] != w2[i] {
+				t.Errorf("GeneratePassphrase() seeded not deterministic: %v != %v", w1, w2)
+				break
+			}
+		}
+	})
+
+	t.Run("case", func(t *testing.T) {
+		tests := []struct {
+			wordCase string
+			expected string
+		}{
+			{PassphraseCaseLower, "apple"},
+			{PassphraseCaseUpper, "APPLE"},
+			{PassphraseCaseTitle, "Apple"},
+			{"", "apple"},
+		}
+
+		for _, tt := range tests {
+			words, err := GeneratePassphrase([]string{"aPPle"}, 1, tt.wordCase, nil)
+			if err != nil {
+				t.Fatalf("GeneratePassphrase(case=%q) error = %v", tt.wordCase, err)
+			}
+			if words[0] != tt.expected {
+				t.Errorf("GeneratePassphrase(case=%q) = %q, want %q", tt.wordCase, words[0], tt.expected)
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := GeneratePassphrase(nil, 3, PassphraseCaseLower, nil); err == nil {
+			t.Error("GeneratePassphrase() expected error for empty word list")
+		}
+		if _, err := GeneratePassphrase(data.EFFShortWords, 0, PassphraseCaseLower, nil); err == nil {
+			t.Error("GeneratePassphrase() expected error for zero words")
+		}
+		if _, err := GeneratePassphrase(data.EFFShortWords, 3, "camel", nil); err == nil {
+			t.Error("GeneratePassphrase() expected error for unsupported case")
+		}
+	})
+}
+
+func TestPassphraseEntropyBits(t *testing.T) {
+	tests := []struct {
+		size, count int
+		expected    float64
+	}{
+		{7776, 6, 6 * math.Log2(7776)},
+		{1296, 4, 4 * math.Log2(1296)},
+		{2, 10, 10},
+		{1, 5, 0},
+		{0, 5, 0},
+	}
+
+	for _, tt := range tests {
+		if got := PassphraseEntropyBits(tt.size, tt.count); math.Abs(got-tt.expected) > 1e-9 {
+			t.Errorf("PassphraseEntropyBits(%d, %d) = %f, want %f", tt.size, tt.count, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"fmt"
+	mathrand "math/rand/v2"
+	"strconv"
+	"strings"
+
+	"github.com/iilei/terraform-provider-idgen/internal/data"
+)
+
+const (
+	// PetnameCategoryAnimal ends a petname with an animal, e.g. "brave-otter".
+	PetnameCategoryAnimal = "animal"
+
+	// PetnameCategoryNoun ends a petname with a noun, e.g. "misty-harbor".
+	PetnameCategoryNoun = "noun"
+
+	// PetnameMinWords is the minimum number of words in a petname.
+	PetnameMinWords = 1
+
+	// PetnameMaxWords is the maximum number of words in a petname.
+	PetnameMaxWords = 4
+
+	// PetnameMaxSuffixDigits is the maximum number of digits of the numeric suffix.
+	PetnameMaxSuffixDigits = 6
+)
+
+// GeneratePetname generates a petname of wordCount words: wordCount-1 distinct
+// adjectives followed by an animal or noun, depending on category.
+// If suffixDigits is greater than zero, a zero-padded number with that many digits
+// is appended as the last element, e.g. "misty-falcon-042".
+//
+// Words are picked by index from the sorted, curated lists in the data package, so
+// if seed is non-nil the result is deterministic.
+// Otherwise, a cryptographically secure random source is used.
+func GeneratePetname(wordCount int, category, separator string, suffixDigits int, seed *int64) (string, error) {
+	if wordCount < PetnameMinWords || wordCount > PetnameMaxWords {
+		return "", fmt.Errorf("word count must be between %d and %d, got %d", PetnameMinWords, PetnameMaxWords, wordCount)
+	}
+	if suffixDigits < 0 || suffixDigits > PetnameMaxSuffixDigits {
+		return "", fmt.Errorf("suffix digits must be between 0 and %d, got %d", PetnameMaxSuffixDigits, suffixDigits)
+	}
+
+	var names []string
+	switch category {
+	case "", PetnameCategoryAnimal:
+		names = data.PetnameAnimals
+	case PetnameCategoryNoun:
+		names = data.PetnameNouns
+	default:
+		return "", fmt.Errorf("unsupported category %q (expected %q or %q)", category, PetnameCategoryAnimal, PetnameCategoryNoun)
+	}
+
+	var rng *mathrand.Rand
+	if seed != nil {
+		// Seeded mode: deterministic generation using math/rand
+		rng = mathrand.New(mathrand.NewPCG(uint64(*seed), uint64(*seed)))
+	} else {
+		// Unseeded mode: ChaCha8 keyed from crypto/rand
+		var key [32]byte
+		if _, err := rand.Read(key[:]); err != nil {
+			return "", err
+		}
+		rng = mathrand.New(mathrand.NewChaCha8(key))
+	}
+
+	parts := make([]string, 0, wordCount+1)
+
+	// Adjectives are drawn without replacement to avoid names like "brave-brave-otter"
+	used := make(map[int]bool, wordCount-1)
+	for len(parts) < wordCount-1 {
+		index := rng.IntN(len(data.PetnameAdjectives))
+		if used[index] {
+			continue
+		}
+		used[index] = true
+		parts = append(parts, data.PetnameAdjectives[index])
+	}
+	parts = append(parts, names[rng.IntN(len(names))])
+
+	if suffixDigits > 0 {
+		limit := 1
+		for range suffixDigits {
+			limit *= 10
+		}
+		suffix := strconv.Itoa(rng.IntN(limit))
+		parts = append(parts, strings.Repeat("0", suffixDigits-len(suffix))+suffix)
+	}
+
+	return strings.Join(parts, separator), nil
+}
@@ -0,0 +1,95 @@
+package idgen
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/iilei/terraform-provider-idgen/internal/data"
+)
+
+func TestGeneratePetname(t *testing.T) {
+	contains := func(list []string, word string) bool {
+		for _, w := range list {
+			if w == word {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("unseeded generation", func(t *testing.T) {
+		name, err := GeneratePetname(2, PetnameCategoryAnimal, "-", 0, nil)
+		if err != nil {
+			t.Fatalf("GeneratePetname() error = %v", err)
+		}
+
+		parts := strings.Split(name, "-")
+		if len(parts) != 2 {
+			t.Fatalf("GeneratePetname() = %q, want 2 words", name)
+		}
+		if !contains(data.PetnameAdjectives, parts[0]) {
+			t.Errorf("GeneratePetname() first word %q is not an adjective", parts[0])
+		}
+		if !contains(data.PetnameAnimals, parts[1]) {
+			t.Errorf("GeneratePetname() last word %q is not an animal", parts[1])
+		}
+	})
+
+	t.Run("seeded generation is deterministic", func(t *testing.T) {
+		seed := int64(12345)
+		n1, _ := GeneratePetname(3, PetnameCategoryNoun, "_", 3, &seed)
+		n2, _ := GeneratePetname(3, PetnameCategoryNoun, "_", 3, &seed)
+		if n1 != n2 {
+			t.Errorf("GeneratePetname() seeded not deterministic: %q != %q", n1, n2)
+		}
+
+		parts := strings.Split(n1, "_")
+		if len(parts) != 4 {
+			t.Fatalf("GeneratePetname() = %q, want 3 words and a suffix", n1)
+		}
+		if !contains(data.PetnameNouns, parts[2]) {
+			t.Errorf("GeneratePetname() last word %q is not a noun", parts[2])
+		}
+		if !regexp.MustCompile(`^[0-9]{3}$`).MatchString(parts[3]) {
+			t.Errorf("GeneratePetname() suffix = %q, want 3 digits", parts[3])
+		}
+	})
+
+	t.Run("adjectives are distinct", func(t *testing.T) {
+		for i := int64(0); i < 200; i++ {
+			name, err := GeneratePetname(PetnameMaxWords, PetnameCategoryAnimal, " ", 0, &i)
+			if err != nil {
+				t.Fatalf("GeneratePetname() error = %v", err)
+			}
+			seen := make(map[string]bool)
+			for _, word := range strings.Fields(name) {
+				if seen[word] {
+					t.Fatalf("GeneratePetname() = %q repeats %q", name, word)
+				}
+				seen[word] = true
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			wordCount    int
+			category     string
+			suffixDigits int
+		}{
+			{"zero words", 0, PetnameCategoryAnimal, 0},
+			{"too many words", PetnameMaxWords + 1, PetnameCategoryAnimal, 0},
+			{"negative suffix", 2, PetnameCategoryAnimal, -1},
+			{"suffix too long", 2, PetnameCategoryAnimal, PetnameMaxSuffixDigits + 1},
+			{"unknown category", 2, "plant", 0},
+		}
+
+		for _, tt := range tests {
+			if _, err := GeneratePetname(tt.wordCount, tt.category, "-", tt.suffixDigits, nil); err == nil {
+				t.Errorf("GeneratePetname(%s) expected error, got nil", tt.name)
+			}
+		}
+	})
+}
@@ -3,7 +3,11 @@ package idgen
 
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
 	mathrand "math/rand/v2"
+	"strings"
+	"unicode"
 
 	"github.com/syrupyy/proquint"
 )
@@ -97,6 +101,10 @@ func GenerateProquint(byteLength int, seed *int64, directEncode bool) (string, e
 	return proquint.EncodeBytes(bytes, "-"), nil
 }
 
+// CanonicalProquintMaxBytes is the maximum number of bytes accepted for canonical encoding
+// (512 bits~>32 proquint words), enough for a SHA-512 digest.
+const CanonicalProquintMaxBytes = 64
+
 // GenerateCanonicalProquint generates a canonical Proquint from a uint64 value.
 // The output length is automatically determined by the value:
 //   - Values 0-4294967295 (uint32 range): 4 bytes~>11 characters (2 proquint words)
@@ -116,5 +124,85 @@ func GenerateCanonicalProquint(value uint64) (string, error) {
 		binary.BigEndian.PutUint32(bytes, uint32(value))
 	}
 
-	return proquint.EncodeBytes(bytes, "-"), nil
+	return EncodeCanonicalProquint(bytes)
+}
+
+// EncodeCanonicalProquint encodes a big-endian byte string as a canonical Proquint,
+// one word per 16 bits, e.g. a 16 byte IPv6 address or UUID~>8 words~>47 characters.
+// The byte string must have an even length between 2 and CanonicalProquintMaxBytes.
+func EncodeCanonicalProquint(bytes []byte) (string, error) {
+	if len(bytes) == 0 || len(bytes)%2 != 0 {
+		return "", fmt.Errorf("canonical encoding requires a whole number of 16-bit words, got %d bytes", len(bytes))
+	}
+	if len(bytes) > CanonicalProquintMaxBytes {
+		return "", fmt.Errorf("canonical encoding supports at most %d bytes, got %d", CanonicalProquintMaxBytes, len(bytes))
+	}
+
+	return FormatProquint(bytes), nil
+}
+
+// FormatProquint formats big-endian bytes as dash-separated proquint words, one word
+// per 16 bits, without the length limit of EncodeCanonicalProquint. It is the normalized
+// form of a decoded proquint.
+func FormatProquint(bytes []byte) string {
+	return proquint.EncodeBytes(bytes, "-")
+}
+
+// DecodeProquint decodes a proquint into its big-endian bytes, two bytes per word.
+//
+// Letters are matched case-insensitively and any character that is not a letter
+// (dashes, spaces, dots, ...) is treated as a separator, so regrouped proquints such
+// as "lusabbabad" or "lusa-bbab-ad" decode like "lusab-babad".
+// Errors name the offending word and its position in the input.
+func DecodeProquint(s string) ([]byte, error) {
+	var letters []byte
+	var positions []int // 1-based rune position of each letter in the input
+
+	position := 0
+	for _, r := range s {
+		position++
+		switch {
+		case r >= 'a' && r <= 'z':
+			letters = append(letters, byte(r))
+		case r >= 'A' && r <= 'Z':
+			letters = append(letters, byte(r-'A'+'a'))
+		case unicode.IsLetter(r):
+			return nil, fmt.Errorf("character %d (%q) is not a proquint letter", position, r)
+		default:
+			continue
+		}
+		positions = append(positions, position)
+	}
+
+	if len(letters) == 0 {
+		return nil, errors.New("proquint must contain at least one word")
+	}
+	if len(letters)%5 != 0 {
+		start := len(letters) - len(letters)%5
+		return nil, fmt.Errorf("proquint has %d letters, which is not a multiple of 5: word %d (%q) is incomplete",
+			len(letters), start/5+1, letters[start:])
+	}
+
+	bytes := make([]byte, 0, len(letters)/5*2)
+	for i := 0; i < len(letters); i += 5 {
+		word := letters[i : i+5]
+		for j, c := range word {
+			alphabet, kind := proquint.Consonants, "consonant"
+			if j%2 == 1 {
+				alphabet, kind = proquint.Vowels, "vowel"
+			}
+			if !strings.ContainsRune(string(alphabet), rune(c)) {
+				return nil, fmt.Errorf("word %d (%q): letter %d %q (character %d of the input) is not a proquint %s, expected one of %q",
+					i/5+1, word, j+1, c, positions[i+j], kind, alphabet)
+			}
+		}
+
+		value, err := proquint.Decode(string(word))
+		if err != nil {
+			return nil, fmt.Errorf("word %d (%q): %w", i/5+1, word, err)
+		}
+		bytes = binary.BigEndian.AppendUint16(bytes, value)
+	}
+
+	return bytes, nil
 }
@@ -2,7 +2,9 @@ package idgen
 
 import (
 	"encoding/binary"
+	"encoding/hex"
 	"net"
+	"strings"
 	"testing"
 )
 
@@ -240,6 +242,118 @@ func TestCanonicalProquintLengths(t *testing.T) {
 	}
 }
 
+func TestEncodeCanonicalProquint(t *testing.T) {
+	tests := []struct {
+		name     string
+		hex      string
+		expected string
+	}{
+		{"single word", "0000", "babab"},
+		{"ipv4", "7f000001", "lusab-babad"},
+		{"ipv6 loopback", "00000000000000000000000000000001", "babab-babab-babab-babab-babab-babab-babab-babad"},
+		{"ipv6 /64 network", "20010db800000000", "fabad-bukum-babab-babab"},
+		{"uuid", "0188f26cda0072b4a6e4adc2899eff1f", "bakam-zanos-tomab-lapuh-piroh-pulaf-mokiv-zusiz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bytes, _ := hex.DecodeString(tt.hex)
+			result, err := EncodeCanonicalProquint(bytes)
+			if err != nil {
+				t.Fatalf("EncodeCanonicalProquint() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("EncodeCanonicalProquint(%s) = %s, want %s", tt.hex, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		for _, length := range []int{0, 1, 3, CanonicalProquintMaxBytes + 2} {
+			if _, err := EncodeCanonicalProquint(make([]byte, length)); err == nil {
+				t.Errorf("EncodeCanonicalProquint(%d bytes) expected error, got nil", length)
+			}
+		}
+	})
+}
+
+func TestDecodeProquint(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		hex   string
+	}{
+		{"canonical ipv4", "lusab-babad", "7f000001"},
+		{"no separator", "lusabbabad", "7f000001"},
+		{"regrouped", "lusa-bbab-ad", "7f000001"},
+		{"mixed separators and case", " Lusab.BABAD\n", "7f000001"},
+		{"single word", "babab", "0000"},
+		{"mac", "popur-sugit-vuruz", "aabbccddeeff"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bytes, err := DecodeProquint(tt.input)
+			if err != nil {
+				t.Fatalf("DecodeProquint(%q) error = %v", tt.input, err)
+			}
+			if got := hex.EncodeToString(bytes); got != tt.hex {
+				t.Errorf("DecodeProquint(%q) = %s, want %s", tt.input, got, tt.hex)
+			}
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		seed := int64(12345)
+		id, _ := GenerateProquint(16, &seed, false)
+		bytes, err := DecodeProquint(id)
+		if err != nil {
+			t.Fatalf("DecodeProquint(%q) error = %v", id, err)
+		}
+		if again, _ := EncodeCanonicalProquint(bytes); again != id {
+			t.Errorf("round trip: got %s, want %s", again, id)
+		}
+	})
+
+	t.Run("longer than canonical encoding", func(t *testing.T) {
+		input := strings.Repeat("babab-", CanonicalProquintMaxBytes/2) + "babad"
+		bytes, err := DecodeProquint(input)
+		if err != nil {
+			t.Fatalf("DecodeProquint error = %v", err)
+		}
+		if len(bytes) != CanonicalProquintMaxBytes+2 {
+			t.Errorf("DecodeProquint returned %d bytes, want %d", len(bytes), CanonicalProquintMaxBytes+2)
+		}
+		if got := FormatProquint(bytes); got != input {
+			t.Errorf("FormatProquint = %s, want %s", got, input)
+		}
+	})
+
+	errorTests := []struct {
+		name    string
+		input   string
+		errPart string
+	}{
+		{"empty", " - ", "at least one word"},
+		{"incomplete word", "lusab-bab", `word 2 ("bab") is incomplete`},
+		{"invalid consonant", "lusab-cabad", `word 2 ("cabad"): letter 1 'c' (character 7 of the input) is not a proquint consonant`},
+		{"invalid vowel", "lusab-babed", `word 2 ("babed"): letter 4 'e' (character 10 of the input) is not a proquint vowel`},
+		{"non-ascii letter", "lüsab-babad", "character 2 ('ü') is not a proquint letter"},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeProquint(tt.input)
+			if err == nil {
+				t.Fatalf("DecodeProquint(%q) expected error, got nil", tt.input)
+			}
+			if !strings.Contains(err.Error(), tt.errPart) {
+				t.Errorf("DecodeProquint(%q) error = %q, want it to contain %q", tt.input, err.Error(), tt.errPart)
+			}
+		})
+	}
+}
+
 func TestGenerateSeededBytes_NonMultipleOf8(t *testing.T) {
 	// Test the missing branch in generateSeededBytes for when remaining < 8
 	testCases := []struct {
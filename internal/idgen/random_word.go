@@ -1,6 +1,7 @@
 package idgen
 
 import (
+	mathrand "math/rand/v2"
 	"sort"
 
 	"github.com/iilei/terraform-provider-idgen/internal/data"
@@ -31,3 +32,18 @@ func GetWordBySeed(seed string, wordlist []string) string {
 
 	return wordlist[index]
 }
+
+// GetRandomWord returns a random word from the wordlist (default: five-letter words).
+// Uses math/rand/v2, which is NOT cryptographically secure.
+func GetRandomWord(wordlist []string) string {
+	// Use default wordlist if none provided
+	if len(wordlist) == 0 {
+		wordlist = data.FiveLetterWords
+	}
+
+	if len(wordlist) == 0 {
+		return ""
+	}
+
+	return wordlist[mathrand.IntN(len(wordlist))]
+}
@@ -2,6 +2,7 @@ package idgen
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"testing"
 )
@@ -197,3 +198,23 @@ func TestGetWordBySeed_NegativeIndexCorrection(t *testing.T) {
 		}
 	}
 }
+
+func TestGetRandomWord(t *testing.T) {
+	wordList := []string{"apple", "berry", "elder", "peach"}
+
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		word := GetRandomWord(wordList)
+		if !slices.Contains(wordList, word) {
+			t.Fatalf("GetRandomWord() = %q, not in the word list", word)
+		}
+		seen[word] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("GetRandomWord() returned only %v in 100 calls", seen)
+	}
+
+	if word := GetRandomWord(nil); len(word) != 5 {
+		t.Errorf("GetRandomWord(nil) = %q, want a five-letter word", word)
+	}
+}
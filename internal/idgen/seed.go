@@ -1,7 +1,9 @@
 package idgen
 
 import (
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
 	"hash/fnv"
 	"net"
 	"strconv"
@@ -36,3 +38,13 @@ func StringToSeed(s string) (int64, bool) {
 	h.Write([]byte(s))
 	return int64(h.Sum64()), false
 }
+
+// DeriveSeed derives an independent sub-seed for key from seed, so that several
+// generators configured with one seed do not share a random stream.
+// The sub-seed is the lowercase hex SHA-256 digest of "<seed>/<key>"; it is part of
+// the provider's compatibility guarantee and never changes between versions.
+// Sub-seeds are never decimal integers or IPv4 addresses, so StringToSeed hashes them.
+func DeriveSeed(seed, key string) string {
+	sum := sha256.Sum256([]byte(seed + "/" + key))
+	return hex.EncodeToString(sum[:])
+}
@@ -279,3 +279,25 @@ func TestStringToSeed_IPv4Conversion(t *testing.T) {
 		})
 	}
 }
+
+func TestDeriveSeed(t *testing.T) {
+	// Sub-seeds are part of the output contract and must never change
+	tests := []struct {
+		seed, key, expected string
+	}{
+		{"app", "call/1", "a6f01686331ac357207745c7ba41690831bdb3f85747ee8414d4a33c7aa5fce3"},
+		{"app", "call/2", "0cf438991c5f092c79c54a93bec45c3d4901c1a45a9d05e9f8e1921e821b0033"},
+		{"", "", "8a5edab282632443219e051e4ade2d1d5bbc671c781051bf1437897cbdfea0f1"},
+	}
+
+	for _, tt := range tests {
+		got := DeriveSeed(tt.seed, tt.key)
+		if got != tt.expected {
+			t.Errorf("DeriveSeed(%q, %q) = %q, want %q", tt.seed, tt.key, got, tt.expected)
+		}
+		// Sub-seeds are hashed, never encoded directly
+		if _, direct := StringToSeed(got); direct {
+			t.Errorf("StringToSeed(DeriveSeed(%q, %q)) is directly encoded", tt.seed, tt.key)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package idgen
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// SpellingAlphabetNATO is the ICAO/NATO spelling alphabet, e.g. "alfa", "bravo", "charlie".
+	SpellingAlphabetNATO = "nato"
+
+	// SpellingAlphabetLAPD is the APCO/LAPD spelling alphabet, e.g. "adam", "boy", "charles".
+	SpellingAlphabetLAPD = "lapd"
+
+	// SpokenSeparator is placed between the spoken words, e.g. "bravo-seven-xray".
+	SpokenSeparator = "-"
+
+	// SpokenCaseMarker precedes uppercase letters when case markers are enabled, e.g. "capital-bravo".
+	SpokenCaseMarker = "capital"
+)
+
+// SpellingAlphabet maps lowercase letters, digits and common punctuation to spoken words.
+type SpellingAlphabet map[rune]string
+
+var spokenDigitsAndPunctuation = map[rune]string{
+	'0': "zero", '1': "one", '2': "two", '3': "three", '4': "four",
+	'5': "five", '6': "six", '7': "seven", '8': "eight", '9': "nine",
+	'-': "dash", '_': "underscore", '.': "dot", ' ': "space", '/': "slash",
+	':': "colon", '+': "plus", '=': "equals", '~': "tilde", '@': "at", '#': "hash",
+}
+
+var spellingAlphabetLetters = map[string][]string{
+	SpellingAlphabetNATO: {
+		"alfa", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel", "india",
+		"juliett", "kilo", "lima", "mike", "november", "oscar", "papa", "quebec", "romeo",
+		"sierra", "tango", "uniform", "victor", "whiskey", "xray", "yankee", "zulu",
+	},
+	SpellingAlphabetLAPD: {
+		"adam", "boy", "charles", "david", "edward", "frank", "george", "henry", "ida",
+		"john", "king", "lincoln", "mary", "nora", "ocean", "peter", "queen", "robert",
+		"sam", "tom", "union", "victor", "william", "xray", "young", "zebra",
+	},
+}
+
+// NewSpellingAlphabet returns the spelling alphabet for spec, which is either the name
+// of a bundled alphabet ("nato", the default, or "lapd") or a comma-separated list of
+// custom "character=word" entries (e.g. "a=apple,b=banana") that override the NATO words.
+func NewSpellingAlphabet(spec string) (SpellingAlphabet, error) {
+	name := spec
+	custom := ""
+	if _, ok := spellingAlphabetLetters[spec]; !ok && spec != "" {
+		name, custom = SpellingAlphabetNATO, spec
+	}
+	if name == "" {
+		name = SpellingAlphabetNATO
+	}
+
+	alphabet := make(SpellingAlphabet, 26+len(spokenDigitsAndPunctuation))
+	for r, word := range spokenDigitsAndPunctuation {
+		alphabet[r] = word
+	}
+	for i, word := range spellingAlphabetLetters[name] {
+		alphabet['a'+rune(i)] = word
+	}
+
+	for _, entry := range strings.Split(custom, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		char, word, ok := strings.Cut(entry, "=")
+		char, word = strings.TrimSpace(char), strings.TrimSpace(word)
+		if !ok || utf8.RuneCountInString(char) != 1 || word == "" {
+			return nil, fmt.Errorf("invalid spelling alphabet entry %q: expected \"character=word\" (e.g. \"a=apple\") "+
+				"or one of %q, %q", entry, SpellingAlphabetNATO, SpellingAlphabetLAPD)
+		}
+		if strings.Contains(word, SpokenSeparator) {
+			return nil, fmt.Errorf("invalid spelling alphabet entry %q: the word must not contain %q, "+
+				"which separates the spoken words", entry, SpokenSeparator)
+		}
+		r, _ := utf8.DecodeRuneInString(char)
+		alphabet[unicode.ToLower(r)] = word
+	}
+
+	return alphabet, nil
+}
+
+// Spell renders s character by character as spoken words joined by SpokenSeparator,
+// e.g. "b7x"~>"bravo-seven-xray". Characters without a spoken word are kept as they are.
+// If markCase is true, uppercase letters are preceded by SpokenCaseMarker
+// ("B"~>"capital-bravo"), so case-sensitive IDs can be read aloud unambiguously.
+func Spell(s string, alphabet SpellingAlphabet, markCase bool) string {
+	words := make([]string, 0, len(s))
+	for _, r := range s {
+		word, ok := alphabet[unicode.ToLower(r)]
+		if !ok {
+			words = append(words, string(r))
+			continue
+		}
+		if markCase && unicode.IsUpper(r) {
+			words = append(words, SpokenCaseMarker)
+		}
+		words = append(words, word)
+	}
+	return strings.Join(words, SpokenSeparator)
+}
+
+// IsCaseSensitive reports whether an alphabet contains both uppercase and lowercase
+// letters, in which case spoken IDs need case markers.
+func IsCaseSensitive(alphabet string) bool {
+	return strings.IndexFunc(alphabet, unicode.IsUpper) >= 0 && strings.IndexFunc(alphabet, unicode.IsLower) >= 0
+}
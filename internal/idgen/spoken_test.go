@@ -0,0 +1,82 @@
+package idgen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSpell(t *testing.T) {
+	nato, err := NewSpellingAlphabet("")
+	if err != nil {
+		t.Fatalf("NewSpellingAlphabet() error = %v", err)
+	}
+
+	tests := []struct {
+		input    string
+		markCase bool
+		expected string
+	}{
+		{"b7x", false, "bravo-seven-xray"},
+		{"B7x", false, "bravo-seven-xray"},
+		{"B7x", true, "capital-bravo-seven-xray"},
+		{"lusab-babad", true, "lima-uniform-sierra-alfa-bravo-dash-bravo-alfa-bravo-alfa-delta"},
+		{"a_b.9", false, "alfa-underscore-bravo-dot-nine"},
+		// No word contains the separator, so the spoken form splits back into one word per character
+		{"x-x", false, "xray-dash-xray"},
+		{"ä!", true, "ä-!"},
+		{"", false, ""},
+	}
+
+	for _, tt := range tests {
+		if got := Spell(tt.input, nato, tt.markCase); got != tt.expected {
+			t.Errorf("Spell(%q, markCase=%t) = %q, want %q", tt.input, tt.markCase, got, tt.expected)
+		}
+	}
+}
+
+func TestNewSpellingAlphabet(t *testing.T) {
+	t.Run("lapd", func(t *testing.T) {
+		lapd, err := NewSpellingAlphabet(SpellingAlphabetLAPD)
+		if err != nil {
+			t.Fatalf("NewSpellingAlphabet() error = %v", err)
+		}
+		if got := Spell("Az1", lapd, true); got != "capital-adam-zebra-one" {
+			t.Errorf("Spell() = %q, want %q", got, "capital-adam-zebra-one")
+		}
+	})
+
+	t.Run("custom entries override nato", func(t *testing.T) {
+		custom, err := NewSpellingAlphabet("A=apple, 0=nought,-=minus")
+		if err != nil {
+			t.Fatalf("NewSpellingAlphabet() error = %v", err)
+		}
+		if got := Spell("ab0-", custom, false); got != "apple-bravo-nought-minus" {
+			t.Errorf("Spell() = %q, want %q", got, "apple-bravo-nought-minus")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, spec := range []string{"klingon", "ab=apple", "a=", "a", "x=x-ray"} {
+			_, err := NewSpellingAlphabet(spec)
+			if err == nil || !strings.Contains(err.Error(), "invalid spelling alphabet entry") {
+				t.Errorf("NewSpellingAlphabet(%q) error = %v, want invalid entry error", spec, err)
+			}
+		}
+	})
+}
+
+func TestIsCaseSensitive(t *testing.T) {
+	tests := map[string]bool{
+		Alphanumeric: true,
+		Numeric:      false,
+		Readable:     true,
+		"abcdef":     false,
+		"ABCDEF":     false,
+	}
+
+	for alphabet, expected := range tests {
+		if got := IsCaseSensitive(alphabet); got != expected {
+			t.Errorf("IsCaseSensitive(%q) = %t, want %t", alphabet, got, expected)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package idgen
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// DNSLabelMaxLength is the maximum length of a DNS label (RFC 1123).
+const DNSLabelMaxLength = 63
+
+// transliterations maps non-ASCII letters to their ASCII spelling. German umlauts and
+// ligatures get their conventional multi-letter forms (ä~>ae, ß~>ss), other letters
+// with diacritics lose the diacritic (é~>e).
+var transliterations = func() map[rune]string {
+	m := map[rune]string{
+		'ä': "ae", 'ö': "oe", 'ü': "ue", 'Ä': "Ae", 'Ö': "Oe", 'Ü': "Ue",
+		'ß': "ss", 'ẞ': "SS",
+		'æ': "ae", 'Æ': "Ae", 'œ': "oe", 'Œ': "Oe", 'ø': "oe", 'Ø': "Oe",
+		'å': "aa", 'Å': "Aa", 'þ': "th", 'Þ': "Th", 'ð': "d", 'Ð': "D",
+		'ĳ': "ij", 'Ĳ': "IJ",
+	}
+
+	diacritics := map[string]string{
+		"àáâãāăą": "a", "ÀÁÂÃĀĂĄ": "A",
+		"çćĉċč": "c", "ÇĆĈĊČ": "C",
+		"ďđ": "d", "ĎĐ": "D",
+		"èéêëēĕėęě": "e", "ÈÉÊËĒĔĖĘĚ": "E",
+		"ĝğġģ": "g", "ĜĞĠĢ": "G",
+		"ĥħ": "h", "ĤĦ": "H",
+		"ìíîïĩīĭįı": "i", "ÌÍÎÏĨĪĬĮİ": "I",
+		"ĵ": "j", "Ĵ": "J",
+		"ķ": "k", "Ķ": "K",
+		"ĺļľŀł": "l", "ĹĻĽĿŁ": "L",
+		"ñńņňŉ": "n", "ÑŃŅŇ": "N",
+		"òóôõōŏő": "o", "ÒÓÔÕŌŎŐ": "O",
+		"ŕŗř": "r", "ŔŖŘ": "R",
+		"śŝşšș": "s", "ŚŜŞŠȘ": "S",
+		"ţťŧț": "t", "ŢŤŦȚ": "T",
+		"ùúûũūŭůűų": "u", "ÙÚÛŨŪŬŮŰŲ": "U",
+		"ŵ": "w", "Ŵ": "W",
+		"ýÿŷ": "y", "ÝŸŶ": "Y",
+		"źżž": "z", "ŹŻŽ": "Z",
+	}
+	for letters, ascii := range diacritics {
+		for _, r := range letters {
+			m[r] = ascii
+		}
+	}
+
+	return m
+}()
+
+// Transliterate converts s to ASCII: letters with a known ASCII spelling are replaced
+// (e.g., "Jürgen Groß"~>"Juergen Gross", "Café"~>"Cafe"), other non-ASCII characters
+// are removed.
+func Transliterate(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r <= unicode.MaxASCII:
+			b.WriteRune(r)
+		default:
+			b.WriteString(transliterations[r])
+		}
+	}
+	return b.String()
+}
+
+// SplitWords transliterates s and splits it into words at non-alphanumeric characters
+// and at case changes, e.g. "Team Namé_v2"~>["Team", "Name", "v2"] and
+// "teamNameV2"~>["team", "Name", "V2"]. Acronyms stay together:
+// "HTTPServer"~>["HTTP", "Server"].
+func SplitWords(s string) []string {
+	runes := []rune(Transliterate(s))
+
+	var words []string
+	start := -1
+	for i, r := range runes {
+		alnum := isASCIILetter(r) || isASCIIDigit(r)
+		if !alnum {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+			continue
+		}
+
+		prev := runes[i-1]
+		lowerToUpper := isASCIIUpper(r) && (isASCIILower(prev) || isASCIIDigit(prev))
+		acronymEnd := isASCIIUpper(r) && isASCIIUpper(prev) && i+1 < len(runes) && isASCIILower(runes[i+1])
+		if lowerToUpper || acronymEnd {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+
+	return words
+}
+
+// Slug converts s to a URL slug: transliterated, lowercase, with each run of characters
+// other than letters and digits replaced by a single dash and no leading or trailing
+// dashes, e.g. "Team Ärger (EU)"~>"team-aerger-eu". Unlike JoinWords, case changes
+// do not separate words ("TeamName"~>"teamname").
+func Slug(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(Transliterate(s)) {
+		if isASCIILower(r) || isASCIIDigit(r) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return b.String()
+}
+
+// JoinWords joins the words of s (see SplitWords) in lowercase with separator,
+// e.g. JoinWords("Team Namé", "-")~>"team-name".
+func JoinWords(s, separator string) string {
+	words := SplitWords(s)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, separator)
+}
+
+// CamelCase joins the words of s (see SplitWords) in camelCase, or in PascalCase if
+// upperFirst is true, e.g. "team name"~>"teamName" or "TeamName".
+func CamelCase(s string, upperFirst bool) string {
+	var b strings.Builder
+	for i, w := range SplitWords(s) {
+		w = strings.ToLower(w)
+		if i > 0 || upperFirst {
+			w = strings.ToUpper(w[:1]) + w[1:]
+		}
+		b.WriteString(w)
+	}
+	return b.String()
+}
+
+// DNSLabel converts s to an RFC 1123 DNS label: the Slug of s cut to at most
+// DNSLabelMaxLength characters, so it starts and ends with a letter or digit.
+// It returns an error if s contains no letters or digits.
+func DNSLabel(s string) (string, error) {
+	label := Slug(s)
+	if label == "" {
+		return "", fmt.Errorf("%q contains no letters or digits to form a DNS label", s)
+	}
+
+	label = strings.TrimRight(Truncate(label, DNSLabelMaxLength), "-")
+	return label, nil
+}
+
+// Pad pads s with padChar to length characters, on the left if left is true and on the
+// right otherwise. Values that are already long enough are returned unchanged.
+func Pad(s string, length int, padChar string, left bool) (string, error) {
+	if len([]rune(padChar)) != 1 {
+		return "", errors.New("padding must be a single character")
+	}
+
+	missing := length - len([]rune(s))
+	if missing <= 0 {
+		return s, nil
+	}
+	if left {
+		return strings.Repeat(padChar, missing) + s, nil
+	}
+	return s + strings.Repeat(padChar, missing), nil
+}
+
+func isASCIILetter(r rune) bool {
+	return isASCIILower(r) || isASCIIUpper(r)
+}
+
+func isASCIILower(r rune) bool {
+	return r >= 'a' && r <= 'z'
+}
+
+func isASCIIUpper(r rune) bool {
+	return r >= 'A' && r <= 'Z'
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,115 @@
+package idgen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTransliterate(t *testing.T) {
+	tests := map[string]string{
+		"Jürgen Groß":   "Juergen Gross",
+		"ÄÖÜ äöü":       "AeOeUe aeoeue",
+		"Café Crème":    "Cafe Creme",
+		"Łódź":          "Lodz",
+		"Smørrebrød":    "Smoerrebroed",
+		"plain-ascii_1": "plain-ascii_1",
+		"emoji 🚀 gone":  "emoji  gone",
+	}
+	for input, expected := range tests {
+		if got := Transliterate(input); got != expected {
+			t.Errorf("Transliterate(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
+
+func TestSplitWords(t *testing.T) {
+	tests := map[string]string{
+		"Team Namé_v2":     "Team,Name,v2",
+		"teamNameV2":       "team,Name,V2",
+		"HTTPServer":       "HTTP,Server",
+		"payments--api  ":  "payments,api",
+		"order.Service-EU": "order,Service,EU",
+		"":                 "",
+	}
+	for input, expected := range tests {
+		if got := strings.Join(SplitWords(input), ","); got != expected {
+			t.Errorf("SplitWords(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
+
+func TestCaseConversions(t *testing.T) {
+	tests := []struct {
+		input  string
+		slug   string
+		kebab  string
+		snake  string
+		camel  string
+		pascal string
+	}{
+		{"Team Ärger (EU)", "team-aerger-eu", "team-aerger-eu", "team_aerger_eu", "teamAergerEu", "TeamAergerEu"},
+		{"paymentsAPIClient", "paymentsapiclient", "payments-api-client", "payments_api_client", "paymentsApiClient", "PaymentsApiClient"},
+		{"  --Data_Platform--  ", "data-platform", "data-platform", "data_platform", "dataPlatform", "DataPlatform"},
+	}
+
+	for _, tt := range tests {
+		if got := Slug(tt.input); got != tt.slug {
+			t.Errorf("Slug(%q) = %q, want %q", tt.input, got, tt.slug)
+		}
+		if got := JoinWords(tt.input, "-"); got != tt.kebab {
+			t.Errorf("JoinWords(%q, \"-\") = %q, want %q", tt.input, got, tt.kebab)
+		}
+		if got := JoinWords(tt.input, "_"); got != tt.snake {
+			t.Errorf("JoinWords(%q, \"_\") = %q, want %q", tt.input, got, tt.snake)
+		}
+		if got := CamelCase(tt.input, false); got != tt.camel {
+			t.Errorf("CamelCase(%q, false) = %q, want %q", tt.input, got, tt.camel)
+		}
+		if got := CamelCase(tt.input, true); got != tt.pascal {
+			t.Errorf("CamelCase(%q, true) = %q, want %q", tt.input, got, tt.pascal)
+		}
+	}
+}
+
+func TestDNSLabel(t *testing.T) {
+	label, err := DNSLabel("Team Ärger (EU)")
+	if err != nil || label != "team-aerger-eu" {
+		t.Errorf("DNSLabel() = %q, %v, want team-aerger-eu", label, err)
+	}
+
+	// Cut to 63 characters without a trailing dash
+	long := strings.Repeat("a", 62) + " b"
+	label, err = DNSLabel(long)
+	if err != nil || label != strings.Repeat("a", 62) {
+		t.Errorf("DNSLabel(long) = %q, %v, want 62 a's", label, err)
+	}
+
+	if _, err := DNSLabel("--- 🚀 ---"); err == nil {
+		t.Error("DNSLabel() expected error for input without letters or digits")
+	}
+}
+
+func TestPad(t *testing.T) {
+	tests := []struct {
+		input    string
+		length   int
+		padChar  string
+		left     bool
+		expected string
+	}{
+		{"42", 5, "0", true, "00042"},
+		{"42", 5, "_", false, "42___"},
+		{"12345", 3, "0", true, "12345"},
+		{"ü", 3, "·", true, "··ü"},
+	}
+	for _, tt := range tests {
+		got, err := Pad(tt.input, tt.length, tt.padChar, tt.left)
+		if err != nil || got != tt.expected {
+			t.Errorf("Pad(%q, %d, %q, %v) = %q, %v, want %q", tt.input, tt.length, tt.padChar, tt.left, got, err, tt.expected)
+		}
+	}
+
+	if _, err := Pad("42", 5, "00", true); err == nil {
+		t.Error("Pad() expected error for multi-character padding")
+	}
+}
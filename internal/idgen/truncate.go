@@ -0,0 +1,62 @@
+package idgen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// TruncateHashLength is the number of hex digits of the hash appended by TruncateWithHash.
+const TruncateHashLength = 8
+
+// TruncateHashMinLength is the smallest maxLength accepted by TruncateWithHash:
+// one character of the value, a dash and the hash.
+const TruncateHashMinLength = TruncateHashLength + 2
+
+// ShortHash returns the first TruncateHashLength hex digits of the SHA-256 digest of s.
+// The output is part of the provider's compatibility guarantee: it never changes
+// between versions, so truncated names stay stable.
+func ShortHash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])[:TruncateHashLength]
+}
+
+// TruncateWithHash shortens s to at most maxLength characters, Kubernetes-style:
+// values that fit are returned unchanged, longer values are cut and a dash and the
+// ShortHash of the full value are appended, so distinct long values stay distinct.
+// Separators ('-', '_', '.') at the cut are dropped to avoid doubled separators,
+// e.g. "payments-service-eu-west-1" with maxLength 18 becomes "payments-9a58e597".
+func TruncateWithHash(s string, maxLength int) (string, error) {
+	return TruncateWithHashSeparator(s, maxLength, "-")
+}
+
+// TruncateWithHashSeparator is TruncateWithHash with a separator of at most one character
+// between the cut value and the hash instead of a dash. An empty separator appends the hash
+// directly, e.g. for names that must not contain dashes.
+func TruncateWithHashSeparator(s string, maxLength int, separator string) (string, error) {
+	if maxLength < TruncateHashMinLength {
+		return "", fmt.Errorf("maximum length must be at least %d to fit a hash suffix, got %d", TruncateHashMinLength, maxLength)
+	}
+
+	runes := []rune(s)
+	if len(runes) <= maxLength {
+		return s, nil
+	}
+
+	prefix := strings.TrimRight(string(runes[:maxLength-TruncateHashLength-utf8.RuneCountInString(separator)]), "-_.")
+	if prefix == "" {
+		return ShortHash(s), nil
+	}
+	return prefix + separator + ShortHash(s), nil
+}
+
+// Truncate cuts s to at most maxLength characters.
+func Truncate(s string, maxLength int) string {
+	runes := []rune(s)
+	if maxLength < 0 || len(runes) <= maxLength {
+		return s
+	}
+	return string(runes[:maxLength])
+}
@@ -0,0 +1,81 @@
+package idgen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestShortHash(t *testing.T) {
+	// The hash is part of the output contract and must never change
+	tests := map[string]string{
+		"":                           "e3b0c442",
+		"payments-service-eu-west-1": "9a58e597",
+	}
+	for input, expected := range tests {
+		if got := ShortHash(input); got != expected {
+			t.Errorf("ShortHash(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
+
+func TestTruncateWithHash(t *testing.T) {
+	tests := []struct {
+		input     string
+		maxLength int
+		expected  string
+	}{
+		{"payments", 20, "payments"},
+		{"payments-service-eu-west-1", 26, "payments-service-eu-west-1"},
+		{"payments-service-eu-west-1", 20, "payments-se-9a58e597"},
+		// Separators at the cut are dropped
+		{"payments-service-eu-west-1", 18, "payments-9a58e597"},
+		{"payments-service-eu-west-1", 10, "p-9a58e597"},
+	}
+
+	for _, tt := range tests {
+		got, err := TruncateWithHash(tt.input, tt.maxLength)
+		if err != nil {
+			t.Fatalf("TruncateWithHash(%q, %d) error = %v", tt.input, tt.maxLength, err)
+		}
+		if got != tt.expected {
+			t.Errorf("TruncateWithHash(%q, %d) = %q, want %q", tt.input, tt.maxLength, got, tt.expected)
+		}
+		if len(got) > tt.maxLength {
+			t.Errorf("TruncateWithHash(%q, %d) = %q exceeds the maximum length", tt.input, tt.maxLength, got)
+		}
+	}
+
+	t.Run("separator", func(t *testing.T) {
+		got, err := TruncateWithHashSeparator("payments-service-eu-west-1", 18, "")
+		if err != nil || got != "payments-s9a58e597" {
+			t.Errorf("TruncateWithHashSeparator() = %q, %v, want payments-s9a58e597", got, err)
+		}
+	})
+
+	t.Run("distinct values stay distinct", func(t *testing.T) {
+		a, _ := TruncateWithHash("payments-service-eu-west-1", 20)
+		b, _ := TruncateWithHash("payments-service-eu-west-2", 20)
+		if a == b {
+			t.Errorf("TruncateWithHash() = %q for both values", a)
+		}
+	})
+
+	t.Run("maximum length too small", func(t *testing.T) {
+		_, err := TruncateWithHash("payments-service", 9)
+		if err == nil || !strings.Contains(err.Error(), "at least 10") {
+			t.Errorf("TruncateWithHash() error = %v, want minimum length error", err)
+		}
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	if got := Truncate("payments", 3); got != "pay" {
+		t.Errorf("Truncate() = %q, want pay", got)
+	}
+	if got := Truncate("pay", 5); got != "pay" {
+		t.Errorf("Truncate() = %q, want pay", got)
+	}
+	if got := Truncate("grüße", 3); got != "grü" {
+		t.Errorf("Truncate() = %q, want grü", got)
+	}
+}
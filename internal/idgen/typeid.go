@@ -0,0 +1,142 @@
+package idgen
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// typeIDAlphabet is the Crockford base32 alphabet (lowercase) used by the TypeID spec.
+	typeIDAlphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+
+	// typeIDSuffixLength is the length of the base32 encoded UUID suffix.
+	typeIDSuffixLength = 26
+
+	// MaxTypeIDPrefixLength is the maximum length of a TypeID prefix.
+	MaxTypeIDPrefixLength = 63
+)
+
+// ValidateTypeIDPrefix checks a prefix against the TypeID specification:
+// at most 63 characters, lowercase ASCII letters and underscores only,
+// starting and ending with a letter. An empty prefix is valid.
+// See: https://github.com/jetify-com/typeid/tree/main/spec
+func ValidateTypeIDPrefix(prefix string) error {
+	if prefix == "" {
+		return nil
+	}
+
+	if len(prefix) > MaxTypeIDPrefixLength {
+		return fmt.Errorf("prefix %q is %d characters long, the maximum is %d", prefix, len(prefix), MaxTypeIDPrefixLength)
+	}
+
+	for i := 0; i < len(prefix); i++ {
+		c := prefix[i]
+		if (c < 'a' || c > 'z') && c != '_' {
+			return fmt.Errorf("prefix %q contains invalid character %q at position %d (allowed: a-z and _)", prefix, c, i)
+		}
+	}
+
+	if prefix[0] == '_' || prefix[len(prefix)-1] == '_' {
+		return fmt.Errorf("prefix %q must start and end with a letter", prefix)
+	}
+
+	return nil
+}
+
+// GenerateTypeID generates a TypeID consisting of the given prefix and a UUIDv7
+// created for the given timestamp, e.g. "user_01h455vb4pex5vsknk084sn02q".
+// If seed is non-nil, the random part of the UUID is deterministic.
+func GenerateTypeID(prefix string, ts time.Time, seed *int64) (string, error) {
+	uuid, err := GenerateUUIDv7(ts, seed)
+	if err != nil {
+		return "", err
+	}
+
+	return EncodeTypeID(prefix, uuid)
+}
+
+// EncodeTypeID encodes an arbitrary UUID as a TypeID with the given prefix.
+func EncodeTypeID(prefix string, uuid [16]byte) (string, error) {
+	if err := ValidateTypeIDPrefix(prefix); err != nil {
+		return "", err
+	}
+
+	suffix := encodeTypeIDSuffix(uuid)
+	if prefix == "" {
+		return suffix, nil
+	}
+
+	return prefix + "_" + suffix, nil
+}
+
+// DecodeTypeID splits a TypeID into its prefix and the underlying UUID in
+// canonical hyphenated form.
+func DecodeTypeID(id string) (string, string, error) {
+	prefix, suffix := "", id
+	if i := strings.LastIndexByte(id, '_'); i >= 0 {
+		prefix, suffix = id[:i], id[i+1:]
+		if prefix == "" {
+			return "", "", errors.New("TypeID must not start with a separator when the prefix is empty")
+		}
+	}
+
+	if err := ValidateTypeIDPrefix(prefix); err != nil {
+		return "", "", err
+	}
+
+	uuid, err := decodeTypeIDSuffix(suffix)
+	if err != nil {
+		return "", "", err
+	}
+
+	return prefix, FormatUUID(uuid), nil
+}
+
+// encodeTypeIDSuffix encodes 128 bits as 26 base32 characters. The encoded value
+// is treated as 130 bits with two leading zero bits, so the first character is
+// always in the range 0-7.
+func encodeTypeIDSuffix(uuid [16]byte) string {
+	var out [typeIDSuffixLength]byte
+
+	for i := range out {
+		var v byte
+		for b := 0; b < 5; b++ {
+			v <<= 1
+			bit := i*5 + b - 2
+			if bit >= 0 && uuid[bit/8]&(0x80>>(bit%8)) != 0 {
+				v |= 1
+			}
+		}
+		out[i] = typeIDAlphabet[v]
+	}
+
+	return string(out[:])
+}
+
+func decodeTypeIDSuffix(suffix string) ([16]byte, error) {
+	var uuid [16]byte
+
+	if len(suffix) != typeIDSuffixLength {
+		return uuid, fmt.Errorf("suffix %q must be %d characters long, got %d", suffix, typeIDSuffixLength, len(suffix))
+	}
+	if suffix[0] > '7' {
+		return uuid, fmt.Errorf("suffix %q exceeds 128 bits (first character must be 0-7)", suffix)
+	}
+
+	for i := 0; i < len(suffix); i++ {
+		v := strings.IndexByte(typeIDAlphabet, suffix[i])
+		if v < 0 {
+			return uuid, fmt.Errorf("suffix %q contains invalid character %q at position %d", suffix, suffix[i], i)
+		}
+		for b := 0; b < 5; b++ {
+			bit := i*5 + b - 2
+			if bit >= 0 && v&(0x10>>b) != 0 {
+				uuid[bit/8] |= 0x80 >> (bit % 8)
+			}
+		}
+	}
+
+	return uuid, nil
+}
@@ -0,0 +1,181 @@
+package idgen
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestTypeIDSpecVectors tests encoding and decoding against the valid cases
+// of the TypeID specification: https://github.com/jetify-com/typeid/tree/main/spec
+func TestTypeIDSpecVectors(t *testing.T) {
+	tests := []struct {
+		name   string
+		typeID string
+		prefix string
+		uuid   string
+	}{
+		{"nil", "00000000000000000000000000", "", "00000000-0000-0000-0000-000000000000"},
+		{"one", "00000000000000000000000001", "", "00000000-0000-0000-0000-000000000001"},
+		{"ten", "0000000000000000000000000a", "", "00000000-0000-0000-0000-00000000000a"},
+		{"sixteen", "0000000000000000000000000g", "", "00000000-0000-0000-0000-000000000010"},
+		{"thirty-two", "00000000000000000000000010", "", "00000000-0000-0000-0000-000000000020"},
+		{"max-valid", "7zzzzzzzzzzzzzzzzzzzzzzzzz", "", "ffffffff-ffff-ffff-ffff-ffffffffffff"},
+		{"valid-alphabet", "prefix_0123456789abcdefghjkmnpqrs", "prefix", "0110c853-1d09-52d8-d73e-1194e95b5f19"},
+		{"valid-uuidv7", "prefix_01h455vb4pex5vsknk084sn02q", "prefix", "01890a5d-ac96-774b-bcce-b302099a8057"},
+		{"prefix-underscore", "pre_fix_00000000000000000000000000", "pre_fix", "00000000-0000-0000-0000-000000000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, uuid, err := DecodeTypeID(tt.typeID)
+			if err != nil {
+				t.Fatalf("DecodeTypeID(%q) error = %v", tt.typeID, err)
+			}
+			if prefix != tt.prefix || uuid != tt.uuid {
+				t.Errorf("DecodeTypeID(%q) = (%q, %q), want (%q, %q)", tt.typeID, prefix, uuid, tt.prefix, tt.uuid)
+			}
+
+			parsed, err := ParseUUID(tt.uuid)
+			if err != nil {
+				t.Fatalf("ParseUUID(%q) error = %v", tt.uuid, err)
+			}
+			encoded, err := EncodeTypeID(tt.prefix, parsed)
+			if err != nil {
+				t.Fatalf("EncodeTypeID() error = %v", err)
+			}
+			if encoded != tt.typeID {
+				t.Errorf("EncodeTypeID(%q, %s) = %q, want %q", tt.prefix, tt.uuid, encoded, tt.typeID)
+			}
+		})
+	}
+}
+
+func TestDecodeTypeIDInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		typeID string
+	}{
+		{"prefix-uppercase", "PREFIX_00000000000000000000000000"},
+		{"prefix-numeric", "12345_00000000000000000000000000"},
+		{"prefix-period", "pre.fix_00000000000000000000000000"},
+		{"prefix-underscore-start", "_prefix_00000000000000000000000000"},
+		{"prefix-underscore-end", "prefix__00000000000000000000000000"},
+		{"prefix-too-long", strings.Repeat("a", 64) + "_00000000000000000000000000"},
+		{"empty-prefix-with-separator", "_00000000000000000000000000"},
+		{"suffix-short", "prefix_1234567890123456789012345"},
+		{"suffix-long", "prefix_123456789012345678901234567"},
+		{"suffix-uppercase", "prefix_0123456789ABCDEFGHJKMNPQRS"},
+		{"suffix-hyphens", "prefix_123456789-123456789-123456"},
+		{"suffix-overflow", "prefix_8zzzzzzzzzzzzzzzzzzzzzzzzz"},
+		{"suffix-ambiguous-chars", "prefix_ooooooiiiiiiuuuuuuulllllll"},
+		{"empty", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := DecodeTypeID(tt.typeID); err == nil {
+				t.Errorf("DecodeTypeID(%q) expected error, got nil", tt.typeID)
+			}
+		})
+	}
+}
+
+func TestValidateTypeIDPrefix(t *testing.T) {
+	valid := []string{"", "user", "a", "pre_fix", strings.Repeat("a", 63)}
+	for _, prefix := range valid {
+		if err := ValidateTypeIDPrefix(prefix); err != nil {
+			t.Errorf("ValidateTypeIDPrefix(%q) error = %v", prefix, err)
+		}
+	}
+
+	invalid := []string{"User", "user1", "_user", "user_", "us-er", strings.Repeat("a", 64)}
+	for _, prefix := range invalid {
+		if err := ValidateTypeIDPrefix(prefix); err == nil {
+			t.Errorf("ValidateTypeIDPrefix(%q) expected error, got nil", prefix)
+		}
+	}
+}
+
+func TestGenerateTypeID(t *testing.T) {
+	ts := time.Date(2023, 6, 25, 12, 0, 0, 0, time.UTC)
+
+	t.Run("seeded generation is deterministic", func(t *testing.T) {
+		seed := int64(42)
+		id1, err := GenerateTypeID("user", ts, &seed)
+		if err != nil {
+			t.Fatalf("GenerateTypeID() error = %v", err)
+		}
+		id2, _ := GenerateTypeID("user", ts, &seed)
+
+		if id1 != id2 {
+			t.Errorf("GenerateTypeID() seeded not deterministic: %q != %q", id1, id2)
+		}
+		if !strings.HasPrefix(id1, "user_") || len(id1) != len("user_")+26 {
+			t.Errorf("GenerateTypeID() = %q, want user_ followed by 26 characters", id1)
+		}
+	})
+
+	t.Run("embeds the timestamp as UUIDv7", func(t *testing.T) {
+		id, err := GenerateTypeID("", ts, nil)
+		if err != nil {
+			t.Fatalf("GenerateTypeID() error = %v", err)
+		}
+
+		_, uuid, err := DecodeTypeID(id)
+		if err != nil {
+			t.Fatalf("DecodeTypeID(%q) error = %v", id, err)
+		}
+
+		// 2023-06-25T12:00:00Z is 1687694400000 ms = 0x188f26cda00
+		if !strings.HasPrefix(uuid, "0188f26c-da00-7") {
+			t.Errorf("DecodeTypeID(%q) uuid = %q, want timestamp 0188f26c-da00 and version 7", id, uuid)
+		}
+		if v := uuid[19]; v != '8' && v != '9' && v != 'a' && v != 'b' {
+			t.Errorf("DecodeTypeID(%q) uuid = %q, want RFC 9562 variant", id, uuid)
+		}
+	})
+
+	t.Run("invalid prefix", func(t *testing.T) {
+		if _, err := GenerateTypeID("User", ts, nil); err == nil {
+			t.Error("GenerateTypeID() expected error for invalid prefix")
+		}
+	})
+
+	t.Run("timestamp before epoch", func(t *testing.T) {
+		if _, err := GenerateTypeID("user", time.Unix(-1, 0), nil); err == nil {
+			t.Error("GenerateTypeID() expected error for negative timestamp")
+		}
+	})
+}
+
+func TestUUIDTimestamp(t *testing.T) {
+	tests := []struct {
+		uuid    string
+		version int
+		want    string
+	}{
+		// RFC 9562 Appendix A test vectors
+		{"c232ab00-9414-11ec-b3c8-9f6bdeced846", 1, "2022-02-22T19:22:22Z"},
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", 7, "2022-02-22T19:22:22Z"},
+		{"919108f7-52d1-4320-9bac-f847db4148a8", 4, ""},
+	}
+
+	for _, tt := range tests {
+		uuid, _ := ParseUUID(tt.uuid)
+		if v := UUIDVersion(uuid); v != tt.version {
+			t.Errorf("UUIDVersion(%q) = %d, want %d", tt.uuid, v, tt.version)
+		}
+
+		ts, ok := UUIDTimestamp(uuid)
+		if tt.want == "" {
+			if ok {
+				t.Errorf("UUIDTimestamp(%q) = %v, want no timestamp", tt.uuid, ts)
+			}
+			continue
+		}
+		if !ok || ts.Format(time.RFC3339Nano) != tt.want {
+			t.Errorf("UUIDTimestamp(%q) = %v, %v, want %s", tt.uuid, ts, ok, tt.want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package idgen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseULID parses a ULID (26 Crockford base32 characters, case-insensitive) into its
+// 128 bits and the embedded timestamp. ULIDs use the same base32 layout as TypeID
+// suffixes: the first 48 bits are a Unix timestamp in milliseconds, the remaining
+// 80 bits are random.
+// See: https://github.com/ulid/spec
+func ParseULID(s string) ([16]byte, time.Time, error) {
+	var id [16]byte
+
+	if len(s) != typeIDSuffixLength {
+		return id, time.Time{}, fmt.Errorf("ULID must be %d characters long, got %d", typeIDSuffixLength, len(s))
+	}
+
+	lower := strings.ToLower(s)
+	for i := 0; i < len(lower); i++ {
+		if strings.IndexByte(typeIDAlphabet, lower[i]) < 0 {
+			return id, time.Time{}, fmt.Errorf("character %d (%q) is not a Crockford base32 character", i+1, s[i])
+		}
+	}
+	if lower[0] > '7' {
+		return id, time.Time{}, fmt.Errorf("ULID %q exceeds 128 bits (first character must be 0-7)", s)
+	}
+
+	id, err := decodeTypeIDSuffix(lower)
+	if err != nil {
+		return id, time.Time{}, err
+	}
+
+	return id, unixMillisPrefix(id), nil
+}
@@ -0,0 +1,41 @@
+package idgen
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseULID(t *testing.T) {
+	t.Run("example from the spec", func(t *testing.T) {
+		id, ts, err := ParseULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+		if err != nil {
+			t.Fatalf("ParseULID() error = %v", err)
+		}
+		if ts.Format(time.RFC3339Nano) != "2016-07-30T23:54:10.259Z" {
+			t.Errorf("ParseULID() timestamp = %v, want 2016-07-30T23:54:10.259Z", ts)
+		}
+		if FormatUUID(id) != "01563e3a-b5d3-d676-4c61-efb99302bd5b" {
+			t.Errorf("ParseULID() = %s, want 01563e3a-b5d3-d676-4c61-efb99302bd5b", FormatUUID(id))
+		}
+	})
+
+	t.Run("case-insensitive", func(t *testing.T) {
+		upper, _, _ := ParseULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+		lower, _, err := ParseULID("01arz3ndektsv4rrffq69g5fav")
+		if err != nil || upper != lower {
+			t.Errorf("ParseULID(lowercase) = %x, %v, want %x", lower, err, upper)
+		}
+	})
+
+	errorTests := map[string]string{
+		"01ARZ3NDEKTSV4RRFFQ69G5FA":  "26 characters",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAU": `character 26 ('U')`,
+		"81ARZ3NDEKTSV4RRFFQ69G5FAV": "exceeds 128 bits",
+	}
+	for input, errPart := range errorTests {
+		if _, _, err := ParseULID(input); err == nil || !strings.Contains(err.Error(), errPart) {
+			t.Errorf("ParseULID(%q) error = %v, want it to contain %q", input, err, errPart)
+		}
+	}
+}
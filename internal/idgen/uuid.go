@@ -0,0 +1,116 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxUUIDv7Millis is the largest Unix millisecond timestamp representable in the
+// 48-bit timestamp field of a UUIDv7.
+const maxUUIDv7Millis = 1<<48 - 1
+
+// GenerateUUIDv7 generates a UUID version 7 (RFC 9562) for the given timestamp.
+// The first 48 bits hold the Unix timestamp in milliseconds, the remaining bits
+// (apart from version and variant) are random.
+// If seed is non-nil, the random bits are generated deterministically from the seed.
+// Otherwise, crypto/rand is used.
+//
+// The timestamp is injected by the caller so that IDs can be fully reproducible
+// when combined with a seed.
+func GenerateUUIDv7(ts time.Time, seed *int64) ([16]byte, error) {
+	var uuid [16]byte
+
+	millis := ts.UnixMilli()
+	if millis < 0 || millis > maxUUIDv7Millis {
+		return uuid, fmt.Errorf("timestamp %s is out of range for UUIDv7", ts.UTC().Format(time.RFC3339))
+	}
+
+	var random []byte
+	if seed != nil {
+		random = generateSeededBytes(*seed, 10)
+	} else {
+		random = make([]byte, 10)
+		if _, err := rand.Read(random); err != nil {
+			return uuid, err
+		}
+	}
+
+	var tsBytes [8]byte
+	binary.BigEndian.PutUint64(tsBytes[:], uint64(millis))
+	copy(uuid[:6], tsBytes[2:])
+	copy(uuid[6:], random)
+
+	// Set version (7) and variant (RFC 9562) bits
+	uuid[6] = (uuid[6] & 0x0f) | 0x70
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+
+	return uuid, nil
+}
+
+// FormatUUID returns the canonical hyphenated lowercase representation of a UUID,
+// e.g. "01890a5d-ac96-774b-bcce-b302099a8057".
+func FormatUUID(uuid [16]byte) string {
+	h := hex.EncodeToString(uuid[:])
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+}
+
+// ParseUUID parses a UUID in canonical hyphenated form or as 32 plain hex characters.
+func ParseUUID(s string) ([16]byte, error) {
+	var uuid [16]byte
+
+	h := s
+	if len(s) == 36 {
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return uuid, fmt.Errorf("invalid UUID %q: misplaced hyphens", s)
+		}
+		h = strings.ReplaceAll(s, "-", "")
+	}
+	if len(h) != 32 {
+		return uuid, fmt.Errorf("invalid UUID %q: expected 32 hex digits", s)
+	}
+
+	if _, err := hex.Decode(uuid[:], []byte(h)); err != nil {
+		return uuid, fmt.Errorf("invalid UUID %q: %w", s, err)
+	}
+
+	return uuid, nil
+}
+
+// uuidV1EpochOffset is the number of 100ns intervals between the Gregorian epoch used
+// by UUIDv1 (1582-10-15) and the Unix epoch.
+const uuidV1EpochOffset = 0x01b21dd213814000
+
+// UUIDVersion returns the version number stored in the UUID (e.g., 4 or 7).
+func UUIDVersion(uuid [16]byte) int {
+	return int(uuid[6] >> 4)
+}
+
+// UUIDTimestamp returns the timestamp embedded in a version 1 or version 7 UUID.
+// The second return value is false for UUID versions without a timestamp.
+func UUIDTimestamp(uuid [16]byte) (time.Time, bool) {
+	switch UUIDVersion(uuid) {
+	case 1:
+		ticks := uint64(uuid[6]&0x0f)<<56 | uint64(uuid[7])<<48 |
+			uint64(binary.BigEndian.Uint16(uuid[4:6]))<<32 | uint64(binary.BigEndian.Uint32(uuid[0:4]))
+		if ticks < uuidV1EpochOffset {
+			return time.Time{}, false
+		}
+		ticks -= uuidV1EpochOffset
+		return time.Unix(int64(ticks/1e7), int64(ticks%1e7)*100).UTC(), true
+	case 7:
+		return unixMillisPrefix(uuid), true
+	}
+	return time.Time{}, false
+}
+
+// unixMillisPrefix interprets the first 48 bits as a Unix timestamp in milliseconds,
+// the layout shared by UUIDv7 and ULID.
+func unixMillisPrefix(b [16]byte) time.Time {
+	var tsBytes [8]byte
+	copy(tsBytes[2:], b[:6])
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(tsBytes[:]))).UTC()
+}
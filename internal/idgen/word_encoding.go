@@ -0,0 +1,91 @@
+package idgen
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// SortedWordlist returns a sorted copy of wordlist without blank or duplicate words.
+// Word encoding uses this order, so the position of a word is its digit value
+// regardless of how the list was supplied.
+func SortedWordlist(wordlist []string) []string {
+	seen := make(map[string]struct{}, len(wordlist))
+	words := make([]string, 0, len(wordlist))
+	for _, word := range wordlist {
+		if _, ok := seen[word]; ok || word == "" {
+			continue
+		}
+		seen[word] = struct{}{}
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	return words
+}
+
+// EncodeWords writes a non-negative integer in base len(wordlist), using the words of
+// the sorted wordlist as digits, most significant word first.
+// For example, with the words "blue,green,red" (base 3), 5 is encoded as "green red".
+// If the result has fewer than minWords words, it is padded with the first word,
+// the equivalent of a leading zero.
+func EncodeWords(value *big.Int, wordlist []string, minWords int) ([]string, error) {
+	if value.Sign() < 0 {
+		return nil, fmt.Errorf("value must not be negative, got %s", value)
+	}
+
+	words := SortedWordlist(wordlist)
+	if len(words) < 2 {
+		return nil, fmt.Errorf("word list must contain at least 2 distinct words, got %d", len(words))
+	}
+
+	base := big.NewInt(int64(len(words)))
+	remaining := new(big.Int).Set(value)
+	digit := new(big.Int)
+
+	// Collect digits least significant first, then reverse
+	var encoded []string
+	for remaining.Sign() > 0 {
+		remaining.QuoRem(remaining, base, digit)
+		encoded = append(encoded, words[digit.Int64()])
+	}
+	for len(encoded) < max(minWords, 1) {
+		encoded = append(encoded, words[0])
+	}
+
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+
+	return encoded, nil
+}
+
+// DecodeWords is the inverse of EncodeWords: it reads words as digits in base
+// len(wordlist) using the sorted wordlist. Leading first words (zeros) are allowed.
+func DecodeWords(encoded []string, wordlist []string) (*big.Int, error) {
+	words := SortedWordlist(wordlist)
+	if len(words) < 2 {
+		return nil, fmt.Errorf("word list must contain at least 2 distinct words, got %d", len(words))
+	}
+	if len(encoded) == 0 {
+		return nil, errors.New("at least one word is required")
+	}
+
+	index := make(map[string]int64, len(words))
+	for i, word := range words {
+		index[word] = int64(i)
+	}
+
+	base := big.NewInt(int64(len(words)))
+	value := new(big.Int)
+	for i, word := range encoded {
+		digit, ok := index[word]
+		if !ok {
+			return nil, fmt.Errorf("word %d (%q) is not in the word list", i+1, word)
+		}
+		value.Mul(value, base)
+		value.Add(value, big.NewInt(digit))
+	}
+
+	return value, nil
+}
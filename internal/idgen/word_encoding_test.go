@@ -0,0 +1,103 @@
+package idgen
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/iilei/terraform-provider-idgen/internal/data"
+)
+
+func TestSortedWordlist(t *testing.T) {
+	got := SortedWordlist([]string{"red", "blue", "", "red", "green"})
+	if strings.Join(got, ",") != "blue,green,red" {
+		t.Errorf("SortedWordlist() = %v, want [blue green red]", got)
+	}
+}
+
+func TestEncodeWords(t *testing.T) {
+	colors := []string{"red", "green", "blue"} // sorted: blue=0, green=1, red=2
+
+	tests := []struct {
+		value    int64
+		minWords int
+		expected string
+	}{
+		{0, 0, "blue"},
+		{1, 0, "green"},
+		{2, 0, "red"},
+		{3, 0, "green blue"},
+		{5, 0, "green red"},
+		{26, 0, "red red red"},
+		{5, 4, "blue blue green red"},
+		{26, 2, "red red red"},
+	}
+
+	for _, tt := range tests {
+		words, err := EncodeWords(big.NewInt(tt.value), colors, tt.minWords)
+		if err != nil {
+			t.Fatalf("EncodeWords(%d) error = %v", tt.value, err)
+		}
+		if got := strings.Join(words, " "); got != tt.expected {
+			t.Errorf("EncodeWords(%d, min %d) = %q, want %q", tt.value, tt.minWords, got, tt.expected)
+		}
+	}
+
+	t.Run("does not modify the word list", func(t *testing.T) {
+		list := []string{"red", "green", "blue"}
+		_, _ = EncodeWords(big.NewInt(7), list, 0)
+		if strings.Join(list, ",") != "red,green,blue" {
+			t.Errorf("EncodeWords() modified the word list: %v", list)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := EncodeWords(big.NewInt(-1), colors, 0); err == nil {
+			t.Error("EncodeWords() expected error for negative value")
+		}
+		if _, err := EncodeWords(big.NewInt(1), []string{"one", "one"}, 0); err == nil {
+			t.Error("EncodeWords() expected error for a single distinct word")
+		}
+	})
+}
+
+func TestDecodeWords(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		for _, wordlist := range [][]string{data.FiveLetterWords, data.EFFLargeWords, data.BIP39English} {
+			for _, s := range []string{"0", "1", "7775", "7776", "123456789", "340282366920938463463374607431768211455"} {
+				value, _ := new(big.Int).SetString(s, 10)
+				words, err := EncodeWords(value, wordlist, 0)
+				if err != nil {
+					t.Fatalf("EncodeWords(%s) error = %v", s, err)
+				}
+				decoded, err := DecodeWords(words, wordlist)
+				if err != nil {
+					t.Fatalf("DecodeWords(%v) error = %v", words, err)
+				}
+				if decoded.Cmp(value) != 0 {
+					t.Errorf("round trip of %s via %v = %s", s, words, decoded)
+				}
+			}
+		}
+	})
+
+	t.Run("leading zeros", func(t *testing.T) {
+		value, err := DecodeWords([]string{"blue", "blue", "green", "red"}, []string{"red", "green", "blue"})
+		if err != nil {
+			t.Fatalf("DecodeWords() error = %v", err)
+		}
+		if value.Int64() != 5 {
+			t.Errorf("DecodeWords() = %s, want 5", value)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := DecodeWords([]string{"green", "purple"}, []string{"red", "green", "blue"})
+		if err == nil || !strings.Contains(err.Error(), `word 2 ("purple")`) {
+			t.Errorf("DecodeWords() error = %v, want it to name word 2", err)
+		}
+		if _, err := DecodeWords(nil, []string{"red", "green"}); err == nil {
+			t.Error("DecodeWords() expected error for no words")
+		}
+	})
+}
@@ -9,4 +9,11 @@ const (
 
 	// WarnIDLength is the threshold at which we warn about unusually long IDs
 	WarnIDLength = 128
+
+	// MaxBatchSize is the maximum number of IDs a templated data source renders in one batch
+	MaxBatchSize = 1000
+
+	// MaxBatchEntryAttempts is how often an unseeded batch entry is rendered again while it
+	// repeats the ID of an earlier entry
+	MaxBatchEntryAttempts = 10
 )
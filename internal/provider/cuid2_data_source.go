@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/iilei/terraform-provider-idgen/internal/idgen"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CUID2DataSource{}
+
+func NewCUID2DataSource() datasource.DataSource {
+	return &CUID2DataSource{}
+}
+
+// CUID2DataSource defines the data source implementation.
+type CUID2DataSource struct{}
+
+// CUID2DataSourceModel describes the data source data model.
+type CUID2DataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Length types.Int64  `tfsdk:"length"`
+	Seed   types.String `tfsdk:"seed"`
+}
+
+func (d *CUID2DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cuid2"
+}
+
+func (d *CUID2DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a [CUID2](https://github.com/paralleldrive/cuid2) identifier.\n\n" +
+			"CUID2s always start with a lowercase letter, followed by lowercase letters and digits " +
+			"derived from a SHA3-512 hash, e.g. `tz4a98xxat96iws9zmbrgj3a`.\n\n" +
+			"**Security Notice:** When using `seed`, IDs become deterministic and predictable. " +
+			"Never use seeded IDs for security tokens, passwords, or cryptographic purposes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The generated CUID2.",
+				Computed:    true,
+			},
+			"length": schema.Int64Attribute{
+				Description: fmt.Sprintf("The length of the generated ID, between %d and %d. Defaults to %d.",
+					idgen.CUID2MinLength, idgen.CUID2MaxLength, idgen.CUID2DefaultLength),
+				Optional: true,
+			},
+			"seed": schema.StringAttribute{
+				MarkdownDescription: "Optional seed for deterministic ID generation. Behavior:\n\n" +
+					"- **Integer** - parsed and used as random seed\n" +
+					"- **Text string** - hashed deterministically and used as random seed\n" +
+					"- **Omitted** - cryptographically random (different each apply)\n\n" +
+					"**Note:** Seeded CUID2s do not include the current time in the hash input.\n\n" +
+					"**WARNING:** Seeded IDs are deterministic and should not be used for security tokens or secrets.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *CUID2DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider configuration is not needed for this implementation
+}
+
+func (d *CUID2DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CUID2DataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := generateCUID2(CUID2Config{Length: data.Length, Seed: data.Seed}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCUID2DataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCUID2DataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.idgen_cuid2.test", "id", regexp.MustCompile(`^[a-z][0-9a-z]{23}$`)),
+				),
+			},
+			{
+				Config: testAccCUID2DataSourceConfigSeeded,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_cuid2.test", "id", "q1z7nj3y10ghsh19mg0ceru2"),
+				),
+			},
+			{
+				Config: testAccCUID2DataSourceConfigCustomLength,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_cuid2.test", "id", "q9j742s0hg"),
+				),
+			},
+			{
+				Config:      testAccCUID2DataSourceConfigInvalidLength,
+				ExpectError: regexp.MustCompile("Invalid length"),
+			},
+		},
+	})
+}
+
+const testAccCUID2DataSourceConfig = `
+data "idgen_cuid2" "test" {}
+`
+
+const testAccCUID2DataSourceConfigSeeded = `
+data "idgen_cuid2" "test" {
+  seed = "42"
+}
+`
+
+const testAccCUID2DataSourceConfigCustomLength = `
+data "idgen_cuid2" "test" {
+  length = 10
+  seed   = "42"
+}
+`
+
+const testAccCUID2DataSourceConfigInvalidLength = `
+data "idgen_cuid2" "test" {
+  length = 33
+}
+`
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 
@@ -309,6 +311,477 @@ func TestTemplatedDataSource_Metadata(t *testing.T) {
 	}
 }
 
+func TestTypeIDDataSource_Configure(t *testing.T) {
+	ds := NewTypeIDDataSource().(*TypeIDDataSource)
+	req := datasource.ConfigureRequest{}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Configure() should not return errors, got: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestTypeIDDataSource_Metadata(t *testing.T) {
+	ds := NewTypeIDDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "idgen",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "idgen_typeid"
+	if resp.TypeName != expected {
+		t.Errorf("Metadata() TypeName = %q, want %q", resp.TypeName, expected)
+	}
+}
+
+func TestCUID2DataSource_Configure(t *testing.T) {
+	ds := NewCUID2DataSource().(*CUID2DataSource)
+	req := datasource.ConfigureRequest{}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Configure() should not return errors, got: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestCUID2DataSource_Metadata(t *testing.T) {
+	ds := NewCUID2DataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "idgen",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "idgen_cuid2"
+	if resp.TypeName != expected {
+		t.Errorf("Metadata() TypeName = %q, want %q", resp.TypeName, expected)
+	}
+}
+
+func TestMnemonicDataSource_Configure(t *testing.T) {
+	ds := NewMnemonicDataSource().(*MnemonicDataSource)
+	req := datasource.ConfigureRequest{}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Configure() should not return errors, got: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestMnemonicDataSource_Metadata(t *testing.T) {
+	ds := NewMnemonicDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "idgen",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "idgen_mnemonic"
+	if resp.TypeName != expected {
+		t.Errorf("Metadata() TypeName = %q, want %q", resp.TypeName, expected)
+	}
+}
+
+func TestMnemonicDecodeDataSource_Configure(t *testing.T) {
+	ds := NewMnemonicDecodeDataSource().(*MnemonicDecodeDataSource)
+	req := datasource.ConfigureRequest{}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Configure() should not return errors, got: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestMnemonicDecodeDataSource_Metadata(t *testing.T) {
+	ds := NewMnemonicDecodeDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "idgen",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "idgen_mnemonic_decode"
+	if resp.TypeName != expected {
+		t.Errorf("Metadata() TypeName = %q, want %q", resp.TypeName, expected)
+	}
+}
+
+func TestPassphraseDataSource_Configure(t *testing.T) {
+	ds := NewPassphraseDataSource().(*PassphraseDataSource)
+	req := datasource.ConfigureRequest{}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Configure() should not return errors, got: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestPassphraseDataSource_Metadata(t *testing.T) {
+	ds := NewPassphraseDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "idgen",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "idgen_passphrase"
+	if resp.TypeName != expected {
+		t.Errorf("Metadata() TypeName = %q, want %q", resp.TypeName, expected)
+	}
+}
+
+func TestResolvePassphraseWordlist(t *testing.T) {
+	if got := len(resolvePassphraseWordlist("")); got != 7776 {
+		t.Errorf("resolvePassphraseWordlist(\"\") length = %d, want 7776", got)
+	}
+	if got := len(resolvePassphraseWordlist("eff_large")); got != 7776 {
+		t.Errorf("resolvePassphraseWordlist(\"eff_large\") length = %d, want 7776", got)
+	}
+	if got := len(resolvePassphraseWordlist("eff_short")); got != 1296 {
+		t.Errorf("resolvePassphraseWordlist(\"eff_short\") length = %d, want 1296", got)
+	}
+
+	custom := resolvePassphraseWordlist("pear, apple,pear,,fig")
+	expected := []string{"apple", "fig", "pear"}
+	if len(custom) != len(expected) {
+		t.Fatalf("resolvePassphraseWordlist(custom) = %v, want %v", custom, expected)
+	}
+	for i := range expected {
+		if custom[i] != expected[i] {
+			t.Errorf("resolvePassphraseWordlist(custom) = %v, want %v", custom, expected)
+			break
+		}
+	}
+}
+
+func TestPetnameDataSource_Configure(t *testing.T) {
+	ds := NewPetnameDataSource().(*PetnameDataSource)
+	req := datasource.ConfigureRequest{}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Configure() should not return errors, got: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestPetnameDataSource_Metadata(t *testing.T) {
+	ds := NewPetnameDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "idgen",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "idgen_petname"
+	if resp.TypeName != expected {
+		t.Errorf("Metadata() TypeName = %q, want %q", resp.TypeName, expected)
+	}
+}
+
+func TestProquintDecodeDataSource_Configure(t *testing.T) {
+	ds := NewProquintDecodeDataSource().(*ProquintDecodeDataSource)
+	req := datasource.ConfigureRequest{}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Configure() should not return errors, got: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestProquintDecodeDataSource_Metadata(t *testing.T) {
+	ds := NewProquintDecodeDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "idgen",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "idgen_proquint_decode"
+	if resp.TypeName != expected {
+		t.Errorf("Metadata() TypeName = %q, want %q", resp.TypeName, expected)
+	}
+}
+
+func TestWordEncodeDataSource_Configure(t *testing.T) {
+	ds := NewWordEncodeDataSource().(*WordEncodeDataSource)
+	req := datasource.ConfigureRequest{}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Configure() should not return errors, got: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestWordEncodeDataSource_Metadata(t *testing.T) {
+	ds := NewWordEncodeDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "idgen",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "idgen_word_encode"
+	if resp.TypeName != expected {
+		t.Errorf("Metadata() TypeName = %q, want %q", resp.TypeName, expected)
+	}
+}
+
+func TestWordDecodeDataSource_Configure(t *testing.T) {
+	ds := NewWordDecodeDataSource().(*WordDecodeDataSource)
+	req := datasource.ConfigureRequest{}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Configure() should not return errors, got: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestWordDecodeDataSource_Metadata(t *testing.T) {
+	ds := NewWordDecodeDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "idgen",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "idgen_word_decode"
+	if resp.TypeName != expected {
+		t.Errorf("Metadata() TypeName = %q, want %q", resp.TypeName, expected)
+	}
+}
+
+func TestResolveWordEncodingWordlist(t *testing.T) {
+	tests := map[string]int{"": 20, "five_letter": 20, "eff_large": 7776, "eff_short": 1296, "bip39": 2048}
+	for input, size := range tests {
+		if got := len(resolveWordEncodingWordlist(input)); got != size {
+			t.Errorf("resolveWordEncodingWordlist(%q) length = %d, want %d", input, got, size)
+		}
+	}
+
+	custom := resolveWordEncodingWordlist("red, green,blue,red")
+	if strings.Join(custom, ",") != "blue,green,red" {
+		t.Errorf("resolveWordEncodingWordlist(custom) = %v, want [blue green red]", custom)
+	}
+}
+
+func TestParseWordEncodingValue(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"0", "0", false},
+		{"4711", "4711", false},
+		{"0xff", "255", false},
+		{"0XFF", "255", false},
+		{"340282366920938463463374607431768211456", "340282366920938463463374607431768211456", false},
+		{"-1", "", true},
+		{"12ab", "", true},
+		{"", "", true},
+		{"0x" + strings.Repeat("f", 129), "", true},
+	}
+
+	for _, tt := range tests {
+		value, errMsg := parseWordEncodingValue(tt.input)
+		if tt.wantErr {
+			if errMsg == "" {
+				t.Errorf("parseWordEncodingValue(%q) expected error, got %s", tt.input, value)
+			}
+			continue
+		}
+		if errMsg != "" || value.String() != tt.want {
+			t.Errorf("parseWordEncodingValue(%q) = %v, %q, want %s", tt.input, value, errMsg, tt.want)
+		}
+	}
+}
+
+func TestParseDataSource_Configure(t *testing.T) {
+	ds := NewParseDataSource().(*ParseDataSource)
+	req := datasource.ConfigureRequest{}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Configure() should not return errors, got: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestParseDataSource_Metadata(t *testing.T) {
+	ds := NewParseDataSource()
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "idgen",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "idgen_parse"
+	if resp.TypeName != expected {
+		t.Errorf("Metadata() TypeName = %q, want %q", resp.TypeName, expected)
+	}
+}
+
+func TestParseFormats(t *testing.T) {
+	t.Run("proquint", func(t *testing.T) {
+		parsed, err := parseProquintFormat("lusab-babad", 0)
+		if err != nil {
+			t.Fatalf("parseProquintFormat() error = %v", err)
+		}
+		if strings.Join(parsed.words, ",") != "lusab,babad" || parsed.parts["hex"] != "7f000001" {
+			t.Errorf("parseProquintFormat() = %+v", parsed)
+		}
+
+		if _, err := parseProquintFormat("lusabb-abad", 4); err == nil || !strings.Contains(err.Error(), `"lusa-bbab-ad"`) {
+			t.Errorf("parseProquintFormat() error = %v, want expected grouping", err)
+		}
+		if _, err := parseProquintFormat("LUSAB-BABAD", 0); err == nil {
+			t.Error("parseProquintFormat() expected error for uppercase input")
+		}
+
+		// The proquint data source generates up to MaxIDLength characters, beyond canonical encoding
+		long := strings.Repeat("babab-", 39) + "babad"
+		if parsed, err := parseProquintFormat(long, 0); err != nil || len(parsed.words) != 40 {
+			t.Errorf("parseProquintFormat(%d words) = %+v, %v, want 40 words", 40, parsed, err)
+		}
+	})
+
+	t.Run("nanoid", func(t *testing.T) {
+		parsed, err := parseNanoIDFormat("6365-9227-83", idgen.Numeric, 12, 4, idgen.CheckDigitDamm)
+		if err != nil {
+			t.Fatalf("parseNanoIDFormat() error = %v", err)
+		}
+		if strings.Join(parsed.groups, ",") != "6365,9227,83" || parsed.parts["payload"] != "636592278" || parsed.parts["check_character"] != "3" {
+			t.Errorf("parseNanoIDFormat() = %+v", parsed)
+		}
+
+		if _, err := parseNanoIDFormat("6365-9227-84", idgen.Numeric, 12, 4, idgen.CheckDigitDamm); err == nil {
+			t.Error("parseNanoIDFormat() expected error for wrong check character")
+		}
+
+		// The check character is the last character, not the last byte
+		parsed, err = parseNanoIDFormat("ζθγε", "αβγδεζηθικ", 4, 0, idgen.CheckDigitDamm)
+		if err != nil {
+			t.Fatalf("parseNanoIDFormat() error = %v", err)
+		}
+		if parsed.parts["payload"] != "ζθγ" || parsed.parts["check_character"] != "ε" {
+			t.Errorf("parseNanoIDFormat() = %+v", parsed)
+		}
+	})
+
+	t.Run("uuid", func(t *testing.T) {
+		parsed, err := parseUUIDFormat("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+		if err != nil {
+			t.Fatalf("parseUUIDFormat() error = %v", err)
+		}
+		if parsed.parts["version"] != "7" || parsed.parts["variant"] != "rfc9562" || len(parsed.groups) != 5 {
+			t.Errorf("parseUUIDFormat() = %+v", parsed)
+		}
+		if parsed.timestamp == nil || parsed.timestamp.Format(time.RFC3339) != "2022-02-22T19:22:22Z" {
+			t.Errorf("parseUUIDFormat() timestamp = %v, want 2022-02-22T19:22:22Z", parsed.timestamp)
+		}
+
+		if _, err := parseUUIDFormat("017f22e279b07cc398c4dc0c0c07398f"); err == nil {
+			t.Error("parseUUIDFormat() expected error for UUID without hyphens")
+		}
+	})
+
+	t.Run("ulid", func(t *testing.T) {
+		parsed, err := parseULIDFormat("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+		if err != nil {
+			t.Fatalf("parseULIDFormat() error = %v", err)
+		}
+		if parsed.timestamp.Format(time.RFC3339Nano) != "2016-07-30T23:54:10.259Z" {
+			t.Errorf("parseULIDFormat() timestamp = %v, want 2016-07-30T23:54:10.259Z", parsed.timestamp)
+		}
+
+		if _, err := parseULIDFormat("81ARZ3NDEKTSV4RRFFQ69G5FAV"); err == nil {
+			t.Error("parseULIDFormat() expected error for ULID exceeding 128 bits")
+		}
+	})
+
+	t.Run("typeid", func(t *testing.T) {
+		parsed, err := parseTypeIDFormat("user_01h455vb4pex5vsknk084sn02q")
+		if err != nil {
+			t.Fatalf("parseTypeIDFormat() error = %v", err)
+		}
+		if parsed.parts["prefix"] != "user" || parsed.timestamp == nil {
+			t.Errorf("parseTypeIDFormat() = %+v", parsed)
+		}
+	})
+}
+
+func TestCompileTemplateLayout(t *testing.T) {
+	layout, names, err := compileTemplateLayout(`{{ .random_word }}.{{ .proquint }}-{{ .nanoid | prepend "n" }}`)
+	if err != nil {
+		t.Fatalf("compileTemplateLayout() error = %v", err)
+	}
+
+	parsed, err := parseTemplateFormat("apple.kufal-zotib-nh84-Hs2", layout, names)
+	if err != nil {
+		t.Fatalf("parseTemplateFormat() error = %v", err)
+	}
+	if parsed.parts["random_word"] != "apple" || parsed.parts["proquint"] != "kufal-zotib" || parsed.parts["nanoid"] != "h84-Hs2" {
+		t.Errorf("parseTemplateFormat() parts = %v", parsed.parts)
+	}
+	if strings.Join(parsed.words, ",") != "apple,kufal,zotib" {
+		t.Errorf("parseTemplateFormat() words = %v, want [apple kufal zotib]", parsed.words)
+	}
+
+	if _, err := parseTemplateFormat("apple.kufal-zotib-h84", layout, names); err == nil {
+		t.Error("parseTemplateFormat() expected error for missing literal")
+	}
+
+	repeated, names, _ := compileTemplateLayout(`{{ .cuid2 }}/{{ .cuid2 }}`)
+	if _, err := parseTemplateFormat("abc/abd", repeated, names); err == nil {
+		t.Error("parseTemplateFormat() expected error for different values of a repeated variable")
+	}
+
+	for _, invalid := range []string{`{{ .proquint | upper }}`, `{{ .nanoid | substr 0 3 }}`, `{{ .proquint }}-{{ .nanoid | slug }}`, `{{ .unknown }}`, `static`, `{{ .proquint`} {
+		if _, _, err := compileTemplateLayout(invalid); err == nil {
+			t.Errorf("compileTemplateLayout(%q) expected error", invalid)
+		}
+	}
+}
+
 func TestParseWordlist(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/iilei/terraform-provider-idgen/internal/idgen"
+)
+
+// Generator specs are shared by the standalone data sources and the components of
+// idgen_templated. Each generate function applies the defaults, validation and
+// diagnostics of its standalone data source, so a templated component yields the
+// same ID as the data source with the same attributes.
+
+// ProquintConfig holds configuration for proquint generation
+type ProquintConfig struct {
+	Length    types.Int64  `tfsdk:"length"`
+	Seed      types.String `tfsdk:"seed"`
+	GroupSize types.Int64  `tfsdk:"group_size"`
+}
+
+// ProquintCanonicalConfig holds configuration for canonical proquint generation
+type ProquintCanonicalConfig struct {
+	Seed      types.String `tfsdk:"seed"`
+	GroupSize types.Int64  `tfsdk:"group_size"`
+}
+
+// NanoIDConfig holds configuration for nanoid generation
+type NanoIDConfig struct {
+	Length     types.Int64  `tfsdk:"length"`
+	Seed       types.String `tfsdk:"seed"`
+	GroupSize  types.Int64  `tfsdk:"group_size"`
+	Alphabet   types.String `tfsdk:"alphabet"`
+	CheckDigit types.String `tfsdk:"check_digit"`
+}
+
+// RandomWordConfig holds configuration for random word generation
+type RandomWordConfig struct {
+	Seed     types.String `tfsdk:"seed"`
+	Wordlist types.String `tfsdk:"wordlist"`
+}
+
+// TypeIDConfig holds configuration for TypeID generation
+type TypeIDConfig struct {
+	Prefix    types.String `tfsdk:"prefix"`
+	Seed      types.String `tfsdk:"seed"`
+	Timestamp types.String `tfsdk:"timestamp"`
+}
+
+// CUID2Config holds configuration for CUID2 generation
+type CUID2Config struct {
+	Length types.Int64  `tfsdk:"length"`
+	Seed   types.String `tfsdk:"seed"`
+}
+
+// Defaults of the generator specs.
+const (
+	defaultProquintLength     = 11
+	defaultProquintGroupSize  = 5
+	defaultNanoIDLength       = 21
+	defaultNanoIDAlphabetName = "readable"
+
+	// templatedNanoIDAlphabetName is the default alphabet of templated nanoids, which
+	// predates the shared specs and keeps existing idgen_templated IDs stable.
+	templatedNanoIDAlphabetName = "alphanumeric"
+)
+
+// canonicalProquintSeedUsage lists the seeds a canonical proquint can encode.
+const canonicalProquintSeedUsage = "Canonical encoding accepts:\n" +
+	"  - IPv4 addresses (e.g., 127.0.0.1)~>11 chars\n" +
+	"  - IPv6 addresses (e.g., 2001:db8::1)~>47 chars\n" +
+	"  - IPv4 CIDR blocks (e.g., 10.0.0.0/16)~>17 chars\n" +
+	"  - IPv6 networks with a prefix length that is a multiple of 16 (e.g., 2001:db8:0:1::/64)~>23 chars for a /64\n" +
+	"  - MAC addresses (e.g., aa:bb:cc:dd:ee:ff)~>17 chars\n" +
+	"  - UUIDs (e.g., 0188f26c-da00-72b4-a6e4-adc2899eff1f)~>47 chars\n" +
+	"  - Hexadecimal strings (e.g., 0x7f000001 or 7f000001)~>11 or 23 chars, longer strings byte for byte (up to 64 bytes)\n" +
+	"  - Unsigned integers 0-4294967295~>11 chars\n" +
+	"  - Unsigned integers 4294967296-18446744073709551615~>23 chars\n" +
+	"  - Unsigned integers up to 340282366920938463463374607431768211455~>47 chars\n\n" +
+	"For generating proquint-formatted IDs from arbitrary strings, use the 'idgen_proquint' data source instead."
+
+// randomSeed returns the random seed for seed, or nil if it is null.
+func randomSeed(seed types.String) *int64 {
+	if seed.IsNull() {
+		return nil
+	}
+	value, _ := stringToSeed(seed.ValueString())
+	return &value
+}
+
+// regroupProquint regroups the letters of a proquint into groups of groupSize
+// (default 5, one group per word); a group size of 0 keeps the ID as it is.
+func regroupProquint(id string, groupSize types.Int64) string {
+	size := defaultProquintGroupSize
+	if !groupSize.IsNull() {
+		size = int(groupSize.ValueInt64())
+	}
+
+	// Remove all dashes and apply grouping
+	if size > 0 {
+		id = strings.ReplaceAll(id, "-", "")
+		id = idgen.ApplyGrouping(id, size)
+	}
+	return id
+}
+
+// generateProquint generates a proquint like idgen_proquint. With directEncode, IPv4 addresses
+// and uint32 seeds are encoded directly like idgen_proquint does; templated components hash
+// every seed, as they always have. Returns "" and adds an error diagnostic if generation fails.
+func generateProquint(config ProquintConfig, directEncode bool, diags *diag.Diagnostics) string {
+	length := int64(defaultProquintLength)
+	if !config.Length.IsNull() {
+		length = config.Length.ValueInt64()
+	}
+
+	// Validate length
+	if !validateLength(length, diags) {
+		return ""
+	}
+
+	// Convert character length to byte length
+	// Proquint: 2 bytes = 1 word (5 chars), separator between words
+	// Approximate: (length + 1) / 6 * 2 bytes
+	byteLength := int((length + 1) / 6 * 2)
+	if byteLength < 2 {
+		byteLength = 2 // Minimum 1 word
+	}
+
+	// Check if seed is provided
+	var seed *int64
+	if !config.Seed.IsNull() {
+		seedVal, shouldDirectEncode := stringToSeed(config.Seed.ValueString())
+		seed = &seedVal
+		directEncode = directEncode && shouldDirectEncode
+
+		// Warn if using direct encoding with non-canonical length
+		if directEncode {
+			// Determine what the canonical length would be
+			canonicalLength := int64(11) // default for uint32
+			if seedVal > 0xFFFFFFFF {
+				canonicalLength = 23 // uint64 range
+			}
+
+			if length != canonicalLength {
+				diags.AddWarning(
+					"Non-Canonical Length for Direct Encoding",
+					fmt.Sprintf(
+						"The seed value '%s' will be canonically encoded to %d characters, but length=%d was requested. "+
+							"The output will be %s to match your requested length. "+
+							"Consider using idgen_proquint_canonical for canonical encoding without specifying length, "+
+							"or adjust length to %d for the standard canonical output.",
+						config.Seed.ValueString(),
+						canonicalLength,
+						length,
+						map[bool]string{true: "truncated", false: "zero-padded"}[length < canonicalLength],
+						canonicalLength,
+					),
+				)
+			}
+		}
+	}
+
+	// Generate the Proquint
+	id, err := idgen.GenerateProquint(byteLength, seed, directEncode)
+	if err != nil {
+		diags.AddError(
+			"Failed to generate Proquint",
+			"Could not generate Proquint: "+err.Error(),
+		)
+		return ""
+	}
+
+	return regroupProquint(id, config.GroupSize)
+}
+
+// generateProquintCanonical encodes the seed as a proquint like idgen_proquint_canonical.
+// Returns "" and adds an error diagnostic if the seed cannot be encoded canonically.
+func generateProquintCanonical(config ProquintCanonicalConfig, diags *diag.Diagnostics) string {
+	if config.Seed.IsNull() {
+		diags.AddError("Seed required", "proquint_canonical requires a seed value")
+		return ""
+	}
+
+	// Parse the seed and check if it's valid for canonical encoding
+	bytes, errMsg := stringToCanonicalValue(config.Seed.ValueString())
+	if errMsg != "" {
+		diags.AddError(
+			"Invalid seed for canonical encoding",
+			fmt.Sprintf(
+				"The seed '%s' cannot be canonically encoded as a proquint.\n\n"+
+					"Error: %s\n\n"+canonicalProquintSeedUsage,
+				config.Seed.ValueString(),
+				errMsg,
+			),
+		)
+		return ""
+	}
+
+	// Generate the canonical proquint
+	id, err := idgen.EncodeCanonicalProquint(bytes)
+	if err != nil {
+		diags.AddError(
+			"Failed to generate canonical Proquint",
+			"Could not generate Proquint: "+err.Error(),
+		)
+		return ""
+	}
+
+	return regroupProquint(id, config.GroupSize)
+}
+
+// templatedNanoIDConfig returns config with the default alphabet of templated nanoids
+// (alphanumeric) if none is configured.
+func templatedNanoIDConfig(config NanoIDConfig) NanoIDConfig {
+	if config.Alphabet.IsNull() {
+		config.Alphabet = types.StringValue(templatedNanoIDAlphabetName)
+	}
+	return config
+}
+
+// nanoIDAlphabet returns the characters of the configured alphabet (default: readable).
+func nanoIDAlphabet(config NanoIDConfig) string {
+	if config.Alphabet.IsNull() {
+		return resolveAlphabet(defaultNanoIDAlphabetName)
+	}
+	return resolveAlphabet(config.Alphabet.ValueString())
+}
+
+// generateNanoID generates a NanoID like idgen_nanoid.
+// Returns "" and adds an error diagnostic if generation fails.
+func generateNanoID(config NanoIDConfig, diags *diag.Diagnostics) string {
+	length := int64(defaultNanoIDLength)
+	if !config.Length.IsNull() {
+		length = config.Length.ValueInt64()
+	}
+
+	// Validate length
+	if !validateLength(length, diags) {
+		return ""
+	}
+
+	alphabet := nanoIDAlphabet(config)
+
+	// Warn if alphabet contains dashes and grouping is enabled
+	if !config.GroupSize.IsNull() && config.GroupSize.ValueInt64() > 0 {
+		if strings.Contains(alphabet, "-") {
+			diags.AddWarning(
+				warningAlphabetContainsDashTitle,
+				warningAlphabetContainsDashDetail,
+			)
+		}
+	}
+
+	// Determine group size for length calculation
+	groupSize := 0
+	if !config.GroupSize.IsNull() {
+		groupSize = int(config.GroupSize.ValueInt64())
+	}
+
+	// Generate the NanoID (grouping is applied internally if groupSize > 0)
+	id, err := idgen.GenerateNanoIDWithCheckDigit(alphabet, int(length), randomSeed(config.Seed), groupSize, config.CheckDigit.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Failed to generate NanoID",
+			"Could not generate NanoID: "+err.Error(),
+		)
+		return ""
+	}
+
+	return id
+}
+
+// generateRandomWord picks a word like idgen_random_word. Without a seed, the word is
+// random (math/rand/v2, not cryptographically secure), like unseeded nanoids and proquints.
+func generateRandomWord(config RandomWordConfig) string {
+	var wordlist []string
+	if !config.Wordlist.IsNull() {
+		wordlist = parseWordlist(config.Wordlist.ValueString())
+	}
+
+	if config.Seed.IsNull() {
+		return idgen.GetRandomWord(wordlist)
+	}
+	return idgen.GetWordBySeed(config.Seed.ValueString(), wordlist)
+}
+
+// generateTypeID generates a TypeID like idgen_typeid.
+// Returns "" and adds an error diagnostic if generation fails.
+func generateTypeID(config TypeIDConfig, diags *diag.Diagnostics) string {
+	prefix := config.Prefix.ValueString()
+	if err := idgen.ValidateTypeIDPrefix(prefix); err != nil {
+		diags.AddError(
+			"Invalid TypeID prefix",
+			err.Error(),
+		)
+		return ""
+	}
+
+	ts := time.Now()
+	if !config.Timestamp.IsNull() {
+		parsed, errMsg := parseTimestamp(config.Timestamp.ValueString())
+		if errMsg != "" {
+			diags.AddError("Invalid timestamp", errMsg)
+			return ""
+		}
+		ts = parsed
+	}
+
+	// Generate the TypeID
+	id, err := idgen.GenerateTypeID(prefix, ts, randomSeed(config.Seed))
+	if err != nil {
+		diags.AddError(
+			"Failed to generate TypeID",
+			"Could not generate TypeID: "+err.Error(),
+		)
+		return ""
+	}
+
+	return id
+}
+
+// generateCUID2 generates a CUID2 like idgen_cuid2.
+// Returns "" and adds an error diagnostic if generation fails.
+func generateCUID2(config CUID2Config, diags *diag.Diagnostics) string {
+	length := idgen.CUID2DefaultLength
+	if !config.Length.IsNull() {
+		length = int(config.Length.ValueInt64())
+	}
+
+	// Validate length
+	if length < idgen.CUID2MinLength || length > idgen.CUID2MaxLength {
+		diags.AddError(
+			"Invalid length",
+			fmt.Sprintf("CUID2 length must be between %d and %d characters", idgen.CUID2MinLength, idgen.CUID2MaxLength),
+		)
+		return ""
+	}
+
+	// Generate the CUID2
+	id, err := idgen.GenerateCUID2(length, randomSeed(config.Seed))
+	if err != nil {
+		diags.AddError(
+			"Failed to generate CUID2",
+			"Could not generate CUID2: "+err.Error(),
+		)
+		return ""
+	}
+
+	return id
+}
+
+// generateComponent runs the generator of the templated component at attrPath and adds its
+// diagnostics to diags, attributed to attrPath. It returns false if the generator reported an error.
+func generateComponent(attrPath path.Path, diags *diag.Diagnostics, generate func(diags *diag.Diagnostics) string) (string, bool) {
+	var componentDiags diag.Diagnostics
+	id := generate(&componentDiags)
+	for _, d := range componentDiags {
+		diags.Append(diag.WithPath(attrPath, d))
+	}
+	return id, !componentDiags.HasError()
+}
@@ -2,12 +2,17 @@ package provider
 
 import (
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"math/big"
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/iilei/terraform-provider-idgen/internal/idgen"
 )
@@ -18,6 +23,51 @@ const (
 		"This may cause confusion when reading the generated ID."
 )
 
+// spellingAlphabetDescription documents the spelling_alphabet attribute shared by data sources with a spoken form.
+const spellingAlphabetDescription = "The spelling alphabet used for the `spoken` attribute:\n\n" +
+	"- **`nato`** (default) - ICAO/NATO alphabet (`alfa`, `bravo`, `charlie`, ...)\n" +
+	"- **`lapd`** - APCO/LAPD alphabet (`adam`, `boy`, `charles`, ...)\n" +
+	"- **Custom** - comma-separated `character=word` entries that override the NATO words (e.g., `a=apple,0=nought`)\n\n" +
+	"Digits and common punctuation are spelled as words (`seven`, `dash`, `underscore`, ...); " +
+	"other characters are kept as they are. No word contains `-`, which separates the spoken words " +
+	"(`x` is spelled `xray`, as ICAO does), so custom words must not contain it either."
+
+// checkDigitDescription documents the check_digit attribute shared by NanoID generators.
+const checkDigitDescription = "Appends a check character computed over the alphabet, so typos can be detected:\n\n" +
+	"- **`luhn_mod_n`** - Luhn mod N, works with any alphabet of at least 2 characters\n" +
+	"- **`damm`** - Damm algorithm, detects all single-character errors and adjacent transpositions; requires a 10-character alphabet (e.g., `numeric`)\n" +
+	"- **`verhoeff`** - Verhoeff algorithm, same guarantees as `damm`; requires a 10-character alphabet\n\n" +
+	"The check character counts towards `length` and grouping is applied after it, " +
+	"so `length` is still the total visible length."
+
+// spellID renders an ID in spoken form (e.g., "b7x"~>"bravo-seven-xray") using the spelling
+// alphabet spec, adding case markers for uppercase letters if markCase is true.
+// Returns a null value and adds an error diagnostic if the spec is invalid.
+func spellID(id string, spec types.String, markCase bool, diags *diag.Diagnostics) types.String {
+	alphabet, err := idgen.NewSpellingAlphabet(spec.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("spelling_alphabet"), "Invalid spelling alphabet", err.Error())
+		return types.StringNull()
+	}
+	return types.StringValue(idgen.Spell(id, alphabet, markCase))
+}
+
+// resolveAlphabet returns the characters of an alphabet preset (alphanumeric, numeric,
+// readable), or alphabet itself as a custom alphabet. Preset names are case-insensitive.
+func resolveAlphabet(alphabet string) string {
+	switch strings.ToLower(alphabet) {
+	case "alphanumeric":
+		return idgen.Alphanumeric
+	case "numeric":
+		return idgen.Numeric
+	case "readable":
+		return idgen.Readable
+	default:
+		// Custom alphabet
+		return alphabet
+	}
+}
+
 // stringToSeed converts a string to an int64 seed and returns whether it should be directly encoded.
 // This is a wrapper around idgen.StringToSeed for use in the provider package.
 func stringToSeed(s string) (int64, bool) {
@@ -25,25 +75,66 @@ func stringToSeed(s string) (int64, bool) {
 }
 
 // stringToCanonicalValue parses a string for canonical proquint encoding.
-// Returns (value, byteSize, error) where:
-//   - value: the numeric value to encode
-//   - byteSize: number of bytes needed (4 for uint32, 8 for uint64)
+// Returns (bytes, error) where:
+//   - bytes: the big-endian byte string to encode, one proquint word per 2 bytes
 //   - error: description if parsing failed
 //
 // Supports:
-//   - IPv4 addresses (e.g., "127.0.0.1")~>uint32~>4 bytes~>11 chars
+//   - IPv4 addresses (e.g., "127.0.0.1")~>4 bytes~>11 chars
+//   - IPv6 addresses (e.g., "2001:db8::1")~>16 bytes~>47 chars
+//   - IPv4 networks (e.g., "10.0.0.0/16")~>4 address bytes + 2 prefix length bytes~>17 chars
+//   - IPv6 networks with a prefix length that is a multiple of 16 (e.g., "2001:db8:0:1::/64")~>prefix/8 bytes
+//   - MAC addresses (e.g., "aa:bb:cc:dd:ee:ff")~>6 bytes~>17 chars
+//   - UUIDs (e.g., "0188f26c-da00-72b4-a6e4-adc2899eff1f")~>16 bytes~>47 chars
 //   - Hexadecimal strings (e.g., "0x7f000001" or "7f000001")~>4 or 8 bytes~>11 or 23 chars
+//     Longer hex strings (more than 16 digits) are encoded byte for byte, left-padded to whole 16-bit words
 //   - uint32 integers (0-4294967295)~>4 bytes~>11 chars
 //   - uint64 integers (4294967296-18446744073709551615)~>8 bytes~>23 chars
-func stringToCanonicalValue(s string) (uint64, int, string) {
-	// Try parsing as IPv4 address first
+//   - uint128 integers (18446744073709551616-2^128-1)~>16 bytes~>47 chars
+func stringToCanonicalValue(s string) ([]byte, string) {
+	if strings.Contains(s, "/") {
+		ip, network, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Sprintf("'%s' is not valid CIDR notation (e.g., 10.0.0.0/16 or 2001:db8::/64)", s)
+		}
+		ones, _ := network.Mask.Size()
+
+		// IPv4 networks: the address as written (2 words) followed by the prefix length (1 word),
+		// so both the address and the prefix length round-trip through decoding
+		if ipv4 := ip.To4(); ipv4 != nil {
+			return binary.BigEndian.AppendUint16(append([]byte{}, ipv4...), uint16(ones)), ""
+		}
+
+		// IPv6 networks: encode the network prefix only, so a /64 becomes 4 words
+		if ones == 0 || ones%16 != 0 {
+			return nil, fmt.Sprintf("IPv6 prefix length must be a non-zero multiple of 16 (one proquint word per 16 bits), got /%d", ones)
+		}
+		return network.IP[:ones/8], ""
+	}
+
+	// Try parsing as IP address
 	if ip := net.ParseIP(s); ip != nil {
 		if ipv4 := ip.To4(); ipv4 != nil {
-			return uint64(binary.BigEndian.Uint32(ipv4)), 4, ""
+			return ipv4, ""
+		}
+		return ip.To16(), ""
+	}
+
+	// MAC addresses (e.g., "aa:bb:cc:dd:ee:ff", "aa-bb-cc-dd-ee-ff" or "aabb.ccdd.eeff"): 6 bytes~>3 words.
+	// IP addresses are parsed first, so colon-separated IPv6 addresses such as
+	// "01:02:03:04:05:06:07:08" are not mistaken for 64-bit MAC addresses
+	if mac, err := net.ParseMAC(s); err == nil {
+		if len(mac) != 6 {
+			return nil, fmt.Sprintf("only 48-bit MAC addresses are supported, got %d bits", len(mac)*8)
+		}
+		return mac, ""
+	}
+
+	// UUIDs in canonical hyphenated form
+	if len(s) == 36 && strings.Count(s, "-") == 4 {
+		if uuid, err := idgen.ParseUUID(s); err == nil {
+			return uuid[:], ""
 		}
-		// IPv6 not supported - the original proquint specification focuses on 32-bit values
-		// Users must convert IPv6 to its 128-bit integer representation first
-		return 0, 0, "IPv6 addresses are not supported for canonical encoding (convert to integer first)"
 	}
 
 	// Check if it looks like hex (starts with 0x or contains a-f/A-F)
@@ -53,32 +144,55 @@ func stringToCanonicalValue(s string) (uint64, int, string) {
 
 	if isHex {
 		// Try to parse as hexadecimal (with or without 0x prefix)
-		hexStr := s
-		if strings.HasPrefix(lowerS, "0x") {
-			hexStr = s[2:]
-		}
-		if val, err := strconv.ParseUint(hexStr, 16, 64); err == nil {
-			if val <= 0xFFFFFFFF {
-				// uint32 range: 4 bytes~>11 chars (2 proquint words)
-				return val, 4, ""
+		hexStr := strings.TrimPrefix(lowerS, "0x")
+		if len(hexStr) > 16 {
+			// Arbitrary-width byte string: keep leading zeros, pad to whole 16-bit words
+			if pad := len(hexStr) % 4; pad != 0 {
+				hexStr = strings.Repeat("0", 4-pad) + hexStr
+			}
+			if len(hexStr)/2 > idgen.CanonicalProquintMaxBytes {
+				return nil, fmt.Sprintf("hexadecimal strings must not exceed %d bytes (%d hex digits)",
+					idgen.CanonicalProquintMaxBytes, 2*idgen.CanonicalProquintMaxBytes)
+			}
+			if bytes, err := hex.DecodeString(hexStr); err == nil {
+				return bytes, ""
 			}
-			// uint64 range: 8 bytes~>23 chars (4 proquint words)
-			return val, 8, ""
+		} else if val, err := strconv.ParseUint(hexStr, 16, 64); err == nil {
+			return uint64ToCanonicalBytes(val), ""
 		}
 	} else {
 		// Try to parse as decimal integer
 		if val, err := strconv.ParseUint(s, 10, 64); err == nil {
-			if val <= 0xFFFFFFFF {
-				// uint32 range: 4 bytes~>11 chars (2 proquint words)
-				return val, 4, ""
-			}
-			// uint64 range: 8 bytes~>23 chars (4 proquint words)
-			return val, 8, ""
+			return uint64ToCanonicalBytes(val), ""
+		}
+		// uint128 range: 16 bytes~>47 chars (8 proquint words)
+		if val, ok := new(big.Int).SetString(s, 10); ok && val.Sign() > 0 && val.BitLen() <= 128 {
+			return val.FillBytes(make([]byte, 16)), ""
 		}
 	}
 
 	// Not a valid canonical value
-	return 0, 0, "value must be an IPv4 address, hexadecimal string (e.g., 0x7f000001), or unsigned integer (0-18446744073709551615)"
+	return nil, "value must be an IP address, CIDR block, MAC address, UUID, hexadecimal string (e.g., 0x7f000001), " +
+		"or unsigned integer (0-340282366920938463463374607431768211455)"
+}
+
+// uint64ToCanonicalBytes returns the big-endian bytes of value:
+// 4 bytes for the uint32 range (2 proquint words), 8 bytes otherwise (4 proquint words).
+func uint64ToCanonicalBytes(value uint64) []byte {
+	if value <= 0xFFFFFFFF {
+		return binary.BigEndian.AppendUint32(nil, uint32(value))
+	}
+	return binary.BigEndian.AppendUint64(nil, value)
+}
+
+// parseTimestamp parses an RFC 3339 timestamp used to seed time-based identifiers.
+// Returns (timestamp, error) where error is a description if parsing failed.
+func parseTimestamp(s string) (time.Time, string) {
+	ts, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Sprintf("The timestamp '%s' is not a valid RFC 3339 timestamp (e.g., 2023-06-25T12:00:00Z)", s)
+	}
+	return ts, ""
 }
 
 // validateLength validates the requested ID length and returns appropriate diagnostics.
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"encoding/hex"
 	"strings"
 	"testing"
 
@@ -58,6 +59,23 @@ func TestApplyGrouping(t *testing.T) {
 	}
 }
 
+func TestResolveAlphabet(t *testing.T) {
+	tests := map[string]string{
+		"alphanumeric": idgen.Alphanumeric,
+		"numeric":      idgen.Numeric,
+		"readable":     idgen.Readable,
+		"abc":          "abc",
+		// Preset names are case-insensitive
+		"Numeric":  idgen.Numeric,
+		"READABLE": idgen.Readable,
+	}
+	for input, expected := range tests {
+		if got := resolveAlphabet(input); got != expected {
+			t.Errorf("resolveAlphabet(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
+
 func TestStringToSeed(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -139,135 +157,236 @@ func TestStringToSeed(t *testing.T) {
 
 func TestStringToCanonicalValue(t *testing.T) {
 	tests := []struct {
-		name         string
-		input        string
-		wantValue    uint64
-		wantByteSize int
-		wantError    string
+		name      string
+		input     string
+		wantHex   string
+		wantError string
 	}{
 		// IPv4 addresses~>4 bytes
 		{
-			name:         "localhost IPv4",
-			input:        "127.0.0.1",
-			wantValue:    2130706433,
-			wantByteSize: 4,
-			wantError:    "",
+			name:      "localhost IPv4",
+			input:     "127.0.0.1",
+			wantHex:   "7f000001",
+			wantError: "",
 		},
 		{
-			name:         "max IPv4",
-			input:        "255.255.255.255",
-			wantValue:    4294967295,
-			wantByteSize: 4,
-			wantError:    "",
+			name:      "max IPv4",
+			input:     "255.255.255.255",
+			wantHex:   "ffffffff",
+			wantError: "",
 		},
 
 		// uint32 range~>4 bytes
 		{
-			name:         "zero",
-			input:        "0",
-			wantValue:    0,
-			wantByteSize: 4,
-			wantError:    "",
+			name:      "zero",
+			input:     "0",
+			wantHex:   "00000000",
+			wantError: "",
 		},
 		{
-			name:         "uint32 max",
-			input:        "4294967295",
-			wantValue:    4294967295,
-			wantByteSize: 4,
-			wantError:    "",
+			name:      "uint32 max",
+			input:     "4294967295",
+			wantHex:   "ffffffff",
+			wantError: "",
 		},
 
 		// uint64 range~>8 bytes
 		{
-			name:         "just above uint32",
-			input:        "4294967296",
-			wantValue:    4294967296,
-			wantByteSize: 8,
-			wantError:    "",
+			name:      "just above uint32",
+			input:     "4294967296",
+			wantHex:   "0000000100000000",
+			wantError: "",
 		},
 		{
-			name:         "max int64",
-			input:        "9223372036854775807",
-			wantValue:    9223372036854775807,
-			wantByteSize: 8,
-			wantError:    "",
+			name:      "max int64",
+			input:     "9223372036854775807",
+			wantHex:   "7fffffffffffffff",
+			wantError: "",
 		},
 		{
-			name:         "max uint64",
-			input:        "18446744073709551615",
-			wantValue:    18446744073709551615,
-			wantByteSize: 8,
-			wantError:    "",
+			name:      "max uint64",
+			input:     "18446744073709551615",
+			wantHex:   "ffffffffffffffff",
+			wantError: "",
 		},
 
 		// Hexadecimal strings
 		{
-			name:         "hex with 0x prefix uint32",
-			input:        "0x7f000001",
-			wantValue:    2130706433,
-			wantByteSize: 4,
-			wantError:    "",
+			name:      "hex with 0x prefix uint32",
+			input:     "0x7f000001",
+			wantHex:   "7f000001",
+			wantError: "",
+		},
+		{
+			name:      "hex without 0x prefix uint32",
+			input:     "7f000001",
+			wantHex:   "7f000001",
+			wantError: "",
+		},
+		{
+			name:      "hex uppercase",
+			input:     "0xFFFFFFFF",
+			wantHex:   "ffffffff",
+			wantError: "",
+		},
+		{
+			name:      "hex uint64",
+			input:     "0x7fffffffffffffff",
+			wantHex:   "7fffffffffffffff",
+			wantError: "",
 		},
 		{
-			name:         "hex without 0x prefix uint32",
-			input:        "7f000001",
-			wantValue:    2130706433,
-			wantByteSize: 4,
-			wantError:    "",
+			name:      "hex max uint64",
+			input:     "0xffffffffffffffff",
+			wantHex:   "ffffffffffffffff",
+			wantError: "",
+		},
+
+		// 128-bit values~>16 bytes
+		{
+			name:      "IPv6 address",
+			input:     "2001:db8::1",
+			wantHex:   "20010db8000000000000000000000001",
+			wantError: "",
+		},
+		{
+			name:      "IPv6 /64 network",
+			input:     "2001:db8:0:1::/64",
+			wantHex:   "20010db800000001",
+			wantError: "",
+		},
+		{
+			name:      "IPv6 /48 network with host bits",
+			input:     "2001:db8:1::abcd/48",
+			wantHex:   "20010db80001",
+			wantError: "",
 		},
 		{
-			name:         "hex uppercase",
-			input:        "0xFFFFFFFF",
-			wantValue:    4294967295,
-			wantByteSize: 4,
-			wantError:    "",
+			name:      "UUID",
+			input:     "0188f26c-da00-72b4-a6e4-adc2899eff1f",
+			wantHex:   "0188f26cda0072b4a6e4adc2899eff1f",
+			wantError: "",
 		},
 		{
-			name:         "hex uint64",
-			input:        "0x7fffffffffffffff",
-			wantValue:    9223372036854775807,
-			wantByteSize: 8,
-			wantError:    "",
+			name:      "uint128 integer",
+			input:     "18446744073709551616",
+			wantHex:   "00000000000000010000000000000000",
+			wantError: "",
 		},
 		{
-			name:         "hex max uint64",
-			input:        "0xffffffffffffffff",
-			wantValue:    18446744073709551615,
-			wantByteSize: 8,
-			wantError:    "",
+			name:      "max uint128",
+			input:     "340282366920938463463374607431768211455",
+			wantHex:   "ffffffffffffffffffffffffffffffff",
+			wantError: "",
+		},
+
+		// MAC addresses~>6 bytes
+		{
+			name:      "MAC address colons",
+			input:     "aa:bb:cc:dd:ee:ff",
+			wantHex:   "aabbccddeeff",
+			wantError: "",
+		},
+		{
+			name:      "MAC address dashes uppercase",
+			input:     "AA-BB-CC-DD-EE-FF",
+			wantHex:   "aabbccddeeff",
+			wantError: "",
+		},
+		{
+			name:      "MAC address dotted",
+			input:     "0000.5e00.5301",
+			wantHex:   "00005e005301",
+			wantError: "",
+		},
+		{
+			name:      "IPv6 address that looks like a MAC address",
+			input:     "01:02:03:04:05:06:07:08",
+			wantHex:   "00010002000300040005000600070008",
+			wantError: "",
+		},
+
+		// IPv4 CIDR~>4 address bytes + 2 prefix length bytes
+		{
+			name:      "IPv4 CIDR",
+			input:     "10.0.0.0/16",
+			wantHex:   "0a0000000010",
+			wantError: "",
+		},
+		{
+			name:      "IPv4 CIDR keeps host address",
+			input:     "192.168.1.10/24",
+			wantHex:   "c0a8010a0018",
+			wantError: "",
+		},
+		{
+			name:      "IPv4 CIDR /0",
+			input:     "0.0.0.0/0",
+			wantHex:   "000000000000",
+			wantError: "",
+		},
+
+		// Arbitrary-width hex~>whole 16-bit words, leading zeros kept
+		{
+			name:      "hex 17 digits",
+			input:     "0x10000000000000000",
+			wantHex:   "00010000000000000000",
+			wantError: "",
+		},
+		{
+			name:      "hex 20 digits with leading zeros",
+			input:     "0x00000000000000000001",
+			wantHex:   "00000000000000000001",
+			wantError: "",
 		},
 
 		// Errors
 		{
-			name:         "IPv6 not supported",
-			input:        "2001:db8::1",
-			wantValue:    0,
-			wantByteSize: 0,
-			wantError:    "IPv6 addresses are not supported for canonical encoding (convert to integer first)",
+			name:      "invalid CIDR",
+			input:     "10.0.0.0/33",
+			wantError: "not valid CIDR notation",
+		},
+		{
+			name:      "EUI-64 not supported",
+			input:     "aa-bb-cc-dd-ee-ff-00-11",
+			wantError: "only 48-bit MAC addresses",
+		},
+		{
+			name:      "IPv6 prefix not a multiple of 16",
+			input:     "2001:db8::/56",
+			wantError: "multiple of 16",
 		},
 		{
-			name:         "invalid string",
-			input:        "not-a-number",
-			wantValue:    0,
-			wantByteSize: 0,
-			wantError:    "value must be an IPv4 address or unsigned integer",
+			name:      "uint128 overflow",
+			input:     "340282366920938463463374607431768211456",
+			wantError: "unsigned integer",
 		},
 		{
-			name:         "negative integer",
-			input:        "-1",
-			wantValue:    0,
-			wantByteSize: 0,
-			wantError:    "value must be an IPv4 address or unsigned integer",
+			name:      "hex too long",
+			input:     "0x" + strings.Repeat("ff", 65),
+			wantError: "must not exceed 64 bytes",
+		},
+		{
+			name:      "invalid string",
+			input:     "not-a-number",
+			wantHex:   "",
+			wantError: "value must be an IP address",
+		},
+		{
+			name:      "negative integer",
+			input:     "-1",
+			wantHex:   "",
+			wantError: "value must be an IP address",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			value, byteSize, errMsg := stringToCanonicalValue(tt.input)
+			bytes, errMsg := stringToCanonicalValue(tt.input)
 
 			if tt.wantError != "" {
-				if errMsg == "" {
-					t.Errorf("expected error containing %q, got no error", tt.wantError)
+				if !strings.Contains(errMsg, tt.wantError) {
+					t.Errorf("expected error containing %q, got %q", tt.wantError, errMsg)
 				}
 				return
 			}
@@ -277,12 +396,8 @@ func TestStringToCanonicalValue(t *testing.T) {
 				return
 			}
 
-			if value != tt.wantValue {
-				t.Errorf("value: got %d, want %d", value, tt.wantValue)
-			}
-
-			if byteSize != tt.wantByteSize {
-				t.Errorf("byteSize: got %d, want %d", byteSize, tt.wantByteSize)
+			if got := hex.EncodeToString(bytes); got != tt.wantHex {
+				t.Errorf("bytes: got %s, want %s", got, tt.wantHex)
 			}
 		})
 	}
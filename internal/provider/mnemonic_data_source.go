@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/iilei/terraform-provider-idgen/internal/idgen"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MnemonicDataSource{}
+
+func NewMnemonicDataSource() datasource.DataSource {
+	return &MnemonicDataSource{}
+}
+
+// MnemonicDataSource defines the data source implementation.
+type MnemonicDataSource struct{}
+
+// MnemonicDataSourceModel describes the data source data model.
+type MnemonicDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	EntropyBits types.Int64  `tfsdk:"entropy_bits"`
+	Entropy     types.String `tfsdk:"entropy"`
+	Separator   types.String `tfsdk:"separator"`
+	Seed        types.String `tfsdk:"seed"`
+}
+
+func (d *MnemonicDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mnemonic"
+}
+
+func (d *MnemonicDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a mnemonic identifier following " +
+			"[BIP39](https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki).\n\n" +
+			"The entropy is encoded as words from the standard 2048-word English list, 11 bits per word. " +
+			"The last word includes a checksum, so typos in a transcribed mnemonic are detected when decoding it with " +
+			"[mnemonic_decode](./mnemonic_decode).\n\n" +
+			"**Output Length:**\n\n" +
+			"- **128 bits**: 12 words\n" +
+			"- **160 bits**: 15 words\n" +
+			"- **192 bits**: 18 words\n" +
+			"- **224 bits**: 21 words\n" +
+			"- **256 bits**: 24 words\n\n" +
+			"**Security Notice:** This data source generates identifiers, not wallet seeds. " +
+			"When using `seed`, mnemonics become deterministic and predictable. " +
+			"Never use generated mnemonics for cryptocurrency wallets, passwords, or cryptographic purposes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The generated mnemonic.",
+				Computed:    true,
+			},
+			"entropy_bits": schema.Int64Attribute{
+				MarkdownDescription: "The amount of entropy to encode: `128`, `160`, `192`, `224` or `256`. Defaults to `128`.",
+				Optional:            true,
+			},
+			"entropy": schema.StringAttribute{
+				MarkdownDescription: "The encoded entropy as hexadecimal string. " +
+					"If set, this value is encoded instead of generating entropy, e.g. `7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f`~>" +
+					"`legal-winner-thank-year-wave-sausage-worth-useful-legal-winner-thank-yellow`. " +
+					"Otherwise it is computed from the generated mnemonic.",
+				Optional: true,
+				Computed: true,
+			},
+			"separator": schema.StringAttribute{
+				MarkdownDescription: "The separator placed between words. Defaults to `-`.",
+				Optional:            true,
+			},
+			"seed": schema.StringAttribute{
+				MarkdownDescription: "Optional seed for deterministic entropy generation. Behavior:\n\n" +
+					"- **Integer** - parsed and used as random seed\n" +
+					"- **Text string** - hashed deterministically and used as random seed\n" +
+					"- **Omitted** - cryptographically random (different each apply)\n\n" +
+					"Cannot be combined with `entropy`.\n\n" +
+					"**WARNING:** Seeded IDs are deterministic and should not be used for security tokens or secrets.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *MnemonicDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider configuration is not needed for this implementation
+}
+
+func (d *MnemonicDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MnemonicDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	separator := "-"
+	if !data.Separator.IsNull() {
+		separator = data.Separator.ValueString()
+	}
+
+	entropyBits := idgen.MnemonicMinEntropyBits
+	if !data.EntropyBits.IsNull() {
+		entropyBits = int(data.EntropyBits.ValueInt64())
+	}
+
+	var words []string
+
+	if !data.Entropy.IsNull() {
+		if !data.Seed.IsNull() {
+			resp.Diagnostics.AddError(
+				"Conflicting attributes",
+				"Only one of 'entropy' and 'seed' can be set.",
+			)
+			return
+		}
+
+		entropy, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(data.Entropy.ValueString()), "0x"))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid entropy",
+				fmt.Sprintf("The entropy '%s' is not a valid hexadecimal string: %s", data.Entropy.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		if !data.EntropyBits.IsNull() && len(entropy)*8 != entropyBits {
+			resp.Diagnostics.AddError(
+				"Invalid entropy",
+				fmt.Sprintf("The entropy has %d bits, but entropy_bits=%d was requested.", len(entropy)*8, entropyBits),
+			)
+			return
+		}
+
+		words, err = idgen.EncodeMnemonic(entropy)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid entropy",
+				"Could not encode entropy as mnemonic: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		if err := idgen.ValidateMnemonicEntropyBits(entropyBits); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid entropy_bits",
+				err.Error(),
+			)
+			return
+		}
+
+		// Check if seed is provided
+		var seed *int64
+		if !data.Seed.IsNull() {
+			seedVal, _ := stringToSeed(data.Seed.ValueString())
+			seed = &seedVal
+		}
+
+		mnemonic, err := idgen.GenerateMnemonic(entropyBits, seed, " ")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to generate mnemonic",
+				"Could not generate mnemonic: "+err.Error(),
+			)
+			return
+		}
+		words = strings.Fields(mnemonic)
+	}
+
+	// Decode again to verify the checksum and expose the entropy
+	entropyHex, err := idgen.DecodeMnemonic(strings.Join(words, " "))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to decode mnemonic",
+			"Could not decode generated mnemonic: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strings.Join(words, separator))
+	if data.Entropy.IsNull() {
+		data.Entropy = types.StringValue(entropyHex)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMnemonicDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMnemonicDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.idgen_mnemonic.test", "id", regexp.MustCompile(`^[a-z]+(-[a-z]+){11}$`)),
+					resource.TestMatchResourceAttr("data.idgen_mnemonic.test", "entropy", regexp.MustCompile(`^[0-9a-f]{32}$`)),
+				),
+			},
+			{
+				Config: testAccMnemonicDataSourceConfigSeeded,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_mnemonic.test", "id",
+						"section pioneer rice fortune chuckle sorry wise venue oblige lizard spread scorpion"),
+					resource.TestCheckResourceAttr("data.idgen_mnemonic.test", "entropy", "c2b4a6e4adc2899eff1f9398305b4c60"),
+				),
+			},
+			{
+				Config: testAccMnemonicDataSourceConfigEntropy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_mnemonic.test", "id",
+						"legal-winner-thank-year-wave-sausage-worth-useful-legal-winner-thank-yellow"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMnemonicDataSourceConfig = `
+data "idgen_mnemonic" "test" {}
+`
+
+const testAccMnemonicDataSourceConfigSeeded = `
+data "idgen_mnemonic" "test" {
+  seed      = "42"
+  separator = " "
+}
+`
+
+const testAccMnemonicDataSourceConfigEntropy = `
+data "idgen_mnemonic" "test" {
+  entropy = "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f"
+}
+`
+
+func TestAccMnemonicDataSource_Invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMnemonicDataSourceConfigInvalidBits,
+				ExpectError: regexp.MustCompile("Invalid entropy_bits"),
+			},
+			{
+				Config:      testAccMnemonicDataSourceConfigInvalidEntropy,
+				ExpectError: regexp.MustCompile("Invalid entropy"),
+			},
+			{
+				Config:      testAccMnemonicDataSourceConfigConflict,
+				ExpectError: regexp.MustCompile("Conflicting attributes"),
+			},
+		},
+	})
+}
+
+const testAccMnemonicDataSourceConfigInvalidBits = `
+data "idgen_mnemonic" "test" {
+  entropy_bits = 100
+}
+`
+
+const testAccMnemonicDataSourceConfigInvalidEntropy = `
+data "idgen_mnemonic" "test" {
+  entropy = "7f7f"
+}
+`
+
+const testAccMnemonicDataSourceConfigConflict = `
+data "idgen_mnemonic" "test" {
+  entropy = "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f"
+  seed    = "42"
+}
+`
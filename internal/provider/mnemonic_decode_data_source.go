@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/iilei/terraform-provider-idgen/internal/idgen"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MnemonicDecodeDataSource{}
+
+func NewMnemonicDecodeDataSource() datasource.DataSource {
+	return &MnemonicDecodeDataSource{}
+}
+
+// MnemonicDecodeDataSource defines the data source implementation.
+type MnemonicDecodeDataSource struct{}
+
+// MnemonicDecodeDataSourceModel describes the data source data model.
+type MnemonicDecodeDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Mnemonic    types.String `tfsdk:"mnemonic"`
+	Entropy     types.String `tfsdk:"entropy"`
+	EntropyBits types.Int64  `tfsdk:"entropy_bits"`
+}
+
+func (d *MnemonicDecodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mnemonic_decode"
+}
+
+func (d *MnemonicDecodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Decodes a [BIP39](https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki) mnemonic " +
+			"back into its entropy, e.g. `legal-winner-thank-year-wave-sausage-worth-useful-legal-winner-thank-yellow`~>" +
+			"`7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f`.\n\n" +
+			"This is the inverse of [mnemonic](./mnemonic). The checksum in the last word is verified, " +
+			"so typos in a transcribed mnemonic are reported instead of decoding to different entropy.\n\n" +
+			"**Input Format:**\n\n" +
+			"- 12, 15, 18, 21 or 24 words from the standard English word list\n" +
+			"- Letters are matched case-insensitively\n" +
+			"- Any other character is treated as a separator (`legal winner ...`, `legal-winner-...` and `Legal_Winner_...` decode the same)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The normalized mnemonic: lowercase words joined by `-`.",
+				Computed:            true,
+			},
+			"mnemonic": schema.StringAttribute{
+				MarkdownDescription: "The mnemonic to decode.",
+				Required:            true,
+			},
+			"entropy": schema.StringAttribute{
+				MarkdownDescription: "The decoded entropy as lowercase hexadecimal.",
+				Computed:            true,
+			},
+			"entropy_bits": schema.Int64Attribute{
+				MarkdownDescription: "The amount of decoded entropy: `128`, `160`, `192`, `224` or `256`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MnemonicDecodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider configuration is not needed for this implementation
+}
+
+func (d *MnemonicDecodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MnemonicDecodeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entropy, err := idgen.DecodeMnemonic(data.Mnemonic.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mnemonic"),
+			"Invalid mnemonic",
+			"Could not decode mnemonic: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strings.Join(idgen.SplitMnemonic(data.Mnemonic.ValueString()), "-"))
+	data.Entropy = types.StringValue(entropy)
+	data.EntropyBits = types.Int64Value(int64(len(entropy) * 4))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
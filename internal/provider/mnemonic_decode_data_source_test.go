@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMnemonicDecodeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMnemonicDecodeDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_mnemonic_decode.test", "id",
+						"legal-winner-thank-year-wave-sausage-worth-useful-legal-winner-thank-yellow"),
+					resource.TestCheckResourceAttr("data.idgen_mnemonic_decode.test", "entropy", "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f"),
+					resource.TestCheckResourceAttr("data.idgen_mnemonic_decode.test", "entropy_bits", "128"),
+				),
+			},
+			// Round trip through idgen_mnemonic
+			{
+				Config: testAccMnemonicDecodeDataSourceConfigRoundTrip,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_mnemonic_decode.test", "entropy", "c2b4a6e4adc2899eff1f9398305b4c60"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMnemonicDecodeDataSourceConfig = `
+data "idgen_mnemonic_decode" "test" {
+  mnemonic = "Legal Winner Thank Year Wave Sausage Worth Useful Legal Winner Thank Yellow"
+}
+`
+
+const testAccMnemonicDecodeDataSourceConfigRoundTrip = `
+data "idgen_mnemonic" "source" {
+  seed = "42"
+}
+
+data "idgen_mnemonic_decode" "test" {
+  mnemonic = data.idgen_mnemonic.source.id
+}
+`
+
+func TestAccMnemonicDecodeDataSource_Invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMnemonicDecodeDataSourceConfigBadChecksum,
+				ExpectError: regexp.MustCompile("checksum mismatch"),
+			},
+			{
+				Config:      testAccMnemonicDecodeDataSourceConfigUnknownWord,
+				ExpectError: regexp.MustCompile(`word 3 \("thanks"\) is not in the BIP39 English word list`),
+			},
+			{
+				Config:      testAccMnemonicDecodeDataSourceConfigWordCount,
+				ExpectError: regexp.MustCompile("must have 12, 15, 18, 21 or 24 words, got 3"),
+			},
+		},
+	})
+}
+
+const testAccMnemonicDecodeDataSourceConfigBadChecksum = `
+data "idgen_mnemonic_decode" "test" {
+  mnemonic = "legal-winner-thank-year-wave-sausage-worth-useful-legal-winner-thank-year"
+}
+`
+
+const testAccMnemonicDecodeDataSourceConfigUnknownWord = `
+data "idgen_mnemonic_decode" "test" {
+  mnemonic = "legal-winner-thanks-year-wave-sausage-worth-useful-legal-winner-thank-yellow"
+}
+`
+
+const testAccMnemonicDecodeDataSourceConfigWordCount = `
+data "idgen_mnemonic_decode" "test" {
+  mnemonic = "legal-winner-thank"
+}
+`
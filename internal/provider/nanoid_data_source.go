@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -23,11 +22,15 @@ type NanoIDDataSource struct{}
 
 // NanoIDDataSourceModel describes the data source data model.
 type NanoIDDataSourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Length    types.Int64  `tfsdk:"length"`
-	Alphabet  types.String `tfsdk:"alphabet"`
-	GroupSize types.Int64  `tfsdk:"group_size"`
-	Seed      types.String `tfsdk:"seed"`
+	ID         types.String `tfsdk:"id"`
+	Length     types.Int64  `tfsdk:"length"`
+	Alphabet   types.String `tfsdk:"alphabet"`
+	GroupSize  types.Int64  `tfsdk:"group_size"`
+	CheckDigit types.String `tfsdk:"check_digit"`
+	Seed       types.String `tfsdk:"seed"`
+
+	Spoken           types.String `tfsdk:"spoken"`
+	SpellingAlphabet types.String `tfsdk:"spelling_alphabet"`
 }
 
 func (d *NanoIDDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -50,13 +53,17 @@ func (d *NanoIDDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			},
 			"alphabet": schema.StringAttribute{
 				Description: "The alphabet to use for ID generation. Can be 'alphanumeric' (a-zA-Z0-9), 'numeric' (0-9), " +
-					"'readable' (excludes 0/O, 1/l/I) or a custom string of characters.",
+					"'readable' (excludes 0/O, 1/l/I) or a custom string of characters. Preset names are case-insensitive.",
 				Optional: true,
 			},
 			"group_size": schema.Int64Attribute{
 				Description: "Number of characters per group, separated by dashes. If not set, no grouping is applied.",
 				Optional:    true,
 			},
+			"check_digit": schema.StringAttribute{
+				MarkdownDescription: checkDigitDescription,
+				Optional:            true,
+			},
 			"seed": schema.StringAttribute{
 				MarkdownDescription: "Optional seed for deterministic ID generation. Behavior:\n\n" +
 					"- **Integer** - parsed and used as random seed\n" +
@@ -65,6 +72,16 @@ func (d *NanoIDDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 					"**WARNING:** Seeded IDs are deterministic and should not be used for security tokens or secrets.",
 				Optional: true,
 			},
+			"spoken": schema.StringAttribute{
+				MarkdownDescription: "The NanoID spelled out for reading aloud, e.g. `capital-kilo-seven-xray` for `K7x`. " +
+					"For case-sensitive alphabets (containing both upper- and lowercase letters), " +
+					"uppercase letters are marked with `capital`.",
+				Computed: true,
+			},
+			"spelling_alphabet": schema.StringAttribute{
+				MarkdownDescription: spellingAlphabetDescription,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -83,68 +100,24 @@ func (d *NanoIDDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// Set defaults
-	length := int(21)
-	if !data.Length.IsNull() {
-		length = int(data.Length.ValueInt64())
+	config := NanoIDConfig{
+		Length:     data.Length,
+		Seed:       data.Seed,
+		GroupSize:  data.GroupSize,
+		Alphabet:   data.Alphabet,
+		CheckDigit: data.CheckDigit,
 	}
-
-	// Validate length
-	if !validateLength(int64(length), &resp.Diagnostics) {
+	id := generateNanoID(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	alphabet := idgen.Readable
-	if !data.Alphabet.IsNull() {
-		alphabetStr := data.Alphabet.ValueString()
-		switch alphabetStr {
-		case "alphanumeric":
-			alphabet = idgen.Alphanumeric
-		case "numeric":
-			alphabet = idgen.Numeric
-		case "readable":
-			alphabet = idgen.Readable
-		default:
-			// Custom alphabet
-			alphabet = alphabetStr
-		}
-	}
-
-	// Warn if alphabet contains dashes and grouping is enabled
-	if !data.GroupSize.IsNull() && data.GroupSize.ValueInt64() > 0 {
-		if !data.Alphabet.IsNull() && strings.Contains(data.Alphabet.ValueString(), "-") {
-			resp.Diagnostics.AddWarning(
-				warningAlphabetContainsDashTitle,
-				warningAlphabetContainsDashDetail,
-			)
-		}
-	}
-
-	// Check if seed is provided
-	var seed *int64
-	if !data.Seed.IsNull() {
-		seedVal, _ := stringToSeed(data.Seed.ValueString())
-		seed = &seedVal
-	}
-
-	// Determine group size for length calculation
-	groupSize := 0
-	if !data.GroupSize.IsNull() {
-		groupSize = int(data.GroupSize.ValueInt64())
-	}
-
-	// Generate the NanoID (grouping is applied internally if groupSize > 0)
-	id, err := idgen.GenerateNanoID(alphabet, length, seed, groupSize)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to generate NanoID",
-			"Could not generate NanoID: "+err.Error(),
-		)
+	data.ID = types.StringValue(id)
+	data.Spoken = spellID(id, data.SpellingAlphabet, idgen.IsCaseSensitive(nanoIDAlphabet(config)), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.ID = types.StringValue(id)
-
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
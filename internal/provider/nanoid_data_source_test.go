@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -27,6 +28,7 @@ func TestAccNanoIDDataSource(t *testing.T) {
 				Config: testAccNanoIDDataSourceConfigSeeded,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.idgen_nanoid.test", "id", "636592278400"),
+					resource.TestCheckResourceAttr("data.idgen_nanoid.test", "spoken", "six-three-six-five-nine-two-two-seven-eight-four-zero-zero"),
 				),
 			},
 			{
@@ -39,6 +41,15 @@ func TestAccNanoIDDataSource(t *testing.T) {
 				Config: testAccNanoIDDataSourceConfigReadableAlphabet,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.idgen_nanoid.test", "id", "CnDx-XfeK-Nw"),
+					resource.TestCheckResourceAttr("data.idgen_nanoid.test", "spoken",
+						"capital-charlie-november-capital-delta-xray-dash-capital-xray-foxtrot-echo-capital-kilo-dash-capital-november-whiskey"),
+				),
+			},
+			{
+				Config: testAccNanoIDDataSourceConfigSpellingAlphabet,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_nanoid.test", "spoken",
+						"capital-charles-nora-capital-david-xray-dash-capital-xray-frank-edward-capital-king-dash-capital-nora-william"),
 				),
 			},
 		},
@@ -82,6 +93,16 @@ data "idgen_nanoid" "test" {
 }
 `
 
+const testAccNanoIDDataSourceConfigSpellingAlphabet = `
+data "idgen_nanoid" "test" {
+  length            = 12
+  group_size        = 4
+  alphabet          = "readable"
+  seed              = "42"
+  spelling_alphabet = "lapd"
+}
+`
+
 func TestAccNanoIDDataSource_DashInAlphabet(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -106,3 +127,56 @@ data "idgen_nanoid" "test" {
   seed       = "42"
 }
 `
+
+func TestAccNanoIDDataSource_CheckDigit(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNanoIDDataSourceConfigCheckDigit,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Grouping is applied after the check character; length is the total visible length
+					resource.TestCheckResourceAttr("data.idgen_nanoid.damm", "id", "6365-9227-83"),
+					resource.TestCheckResourceAttr("data.idgen_nanoid.luhn", "id", "CnDxXfeKNF"),
+					resource.TestCheckResourceAttr("data.idgen_nanoid.verhoeff", "id", "64414696"),
+				),
+			},
+			{
+				Config:      testAccNanoIDDataSourceConfigCheckDigitInvalid,
+				ExpectError: regexp.MustCompile(`exactly 10 characters`),
+			},
+		},
+	})
+}
+
+const testAccNanoIDDataSourceConfigCheckDigit = `
+data "idgen_nanoid" "damm" {
+  length      = 12
+  group_size  = 4
+  alphabet    = "numeric"
+  check_digit = "damm"
+  seed        = "42"
+}
+
+data "idgen_nanoid" "luhn" {
+  length      = 10
+  alphabet    = "readable"
+  check_digit = "luhn_mod_n"
+  seed        = "42"
+}
+
+data "idgen_nanoid" "verhoeff" {
+  length      = 8
+  alphabet    = "numeric"
+  check_digit = "verhoeff"
+  seed        = "order-7"
+}
+`
+
+const testAccNanoIDDataSourceConfigCheckDigitInvalid = `
+data "idgen_nanoid" "test" {
+  alphabet    = "readable"
+  check_digit = "damm"
+}
+`
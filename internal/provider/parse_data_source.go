@@ -0,0 +1,490 @@
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/iilei/terraform-provider-idgen/internal/idgen"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ParseDataSource{}
+
+func NewParseDataSource() datasource.DataSource {
+	return &ParseDataSource{}
+}
+
+// ParseDataSource defines the data source implementation.
+type ParseDataSource struct{}
+
+// ParseDataSourceModel describes the data source data model.
+type ParseDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Input      types.String `tfsdk:"input"`
+	Format     types.String `tfsdk:"format"`
+	Alphabet   types.String `tfsdk:"alphabet"`
+	Length     types.Int64  `tfsdk:"length"`
+	GroupSize  types.Int64  `tfsdk:"group_size"`
+	CheckDigit types.String `tfsdk:"check_digit"`
+	Template   types.String `tfsdk:"template"`
+
+	Valid     types.Bool   `tfsdk:"valid"`
+	Reason    types.String `tfsdk:"reason"`
+	Words     types.List   `tfsdk:"words"`
+	Groups    types.List   `tfsdk:"groups"`
+	Timestamp types.String `tfsdk:"timestamp"`
+	Parts     types.Map    `tfsdk:"parts"`
+}
+
+// Formats supported by the parse data source.
+const (
+	parseFormatProquint = "proquint"
+	parseFormatNanoID   = "nanoid"
+	parseFormatUUID     = "uuid"
+	parseFormatULID     = "ulid"
+	parseFormatTypeID   = "typeid"
+	parseFormatTemplate = "template"
+)
+
+// proquintWordPattern matches a single proquint word (consonant-vowel-consonant-vowel-consonant).
+const proquintWordPattern = `[bdfghjklmnprstvz][aiou][bdfghjklmnprstvz][aiou][bdfghjklmnprstvz]`
+
+// templateLayoutPatterns maps the variables of the templated data source to the syntax
+// their values have with the default component settings.
+var templateLayoutPatterns = map[string]string{
+	"proquint":           proquintWordPattern + `(?:-` + proquintWordPattern + `)*`,
+	"proquint_canonical": proquintWordPattern + `(?:-` + proquintWordPattern + `)*`,
+	"nanoid":             `[0-9A-Za-z]+(?:-[0-9A-Za-z]+)*`,
+	"random_word":        `[A-Za-z]+`,
+	"typeid":             `(?:[a-z](?:[a-z_]*[a-z])?_)?[0-7][0-9a-hjkmnp-tv-z]{25}`,
+	"cuid2":              `[a-z][0-9a-z]*`,
+}
+
+// parsedID holds the structured parts of an ID recognized by the parse data source.
+type parsedID struct {
+	words     []string
+	groups    []string
+	timestamp *time.Time
+	parts     map[string]string
+}
+
+func (d *ParseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_parse"
+}
+
+func (d *ParseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks whether a string matches an expected ID format and splits it into its parts.\n\n" +
+			"Invalid input does not fail the plan: `valid` is `false` and `reason` explains the mismatch, " +
+			"so the result can be asserted in `check` blocks or `precondition`s. " +
+			"Only an invalid format specification (e.g., an unknown `format` or a broken `template`) is an error.\n\n" +
+			"**Formats:**\n\n" +
+			"- **`proquint`** - lowercase proquint words, grouped as by [proquint](./proquint) (`group_size`, default 5)\n" +
+			"- **`nanoid`** - characters of `alphabet`, with optional `length`, `group_size` and `check_digit` as in [nanoid](./nanoid)\n" +
+			"- **`uuid`** - a UUID in canonical hyphenated form (any version)\n" +
+			"- **`ulid`** - a ULID (26 Crockford base32 characters)\n" +
+			"- **`typeid`** - a TypeID as generated by [typeid](./typeid)\n" +
+			"- **`template`** - a layout written like the `template` of [templated](./templated), e.g. `{{ .random_word }}-{{ .nanoid }}`",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The parsed input.",
+				Computed:            true,
+			},
+			"input": schema.StringAttribute{
+				MarkdownDescription: "The string to parse.",
+				Required:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "The expected format: `proquint`, `nanoid`, `uuid`, `ulid`, `typeid` or `template`.",
+				Required:            true,
+			},
+			"alphabet": schema.StringAttribute{
+				MarkdownDescription: "For `nanoid`: the alphabet preset (`alphanumeric`, `numeric`, `readable`, case-insensitive) or a custom string of characters. " +
+					"Defaults to `readable`, like [nanoid](./nanoid).",
+				Optional: true,
+			},
+			"length": schema.Int64Attribute{
+				MarkdownDescription: "For `nanoid`: the expected total length including grouping dashes. If not set, any length is accepted.",
+				Optional:            true,
+			},
+			"group_size": schema.Int64Attribute{
+				MarkdownDescription: "For `nanoid` and `proquint`: the expected number of characters per dash-separated group. " +
+					"For `nanoid`, no grouping is expected if not set; for `proquint`, the default is one word (5 characters) per group.",
+				Optional: true,
+			},
+			"check_digit": schema.StringAttribute{
+				MarkdownDescription: "For `nanoid`: the check digit algorithm (`luhn_mod_n`, `damm`, `verhoeff`) the last character is verified with.",
+				Optional:            true,
+			},
+			"template": schema.StringAttribute{
+				MarkdownDescription: "For `template`: the expected layout using the variables of [templated](./templated). " +
+					"Each variable matches its component with default settings:\n\n" +
+					"- `.proquint`, `.proquint_canonical` - proquint words separated by dashes\n" +
+					"- `.nanoid` - alphanumeric characters, optionally in dash-separated groups\n" +
+					"- `.random_word` - letters\n" +
+					"- `.typeid` - a TypeID\n" +
+					"- `.cuid2` - a lowercase letter followed by lowercase letters and digits\n\n" +
+					"Template functions may add text around a variable (e.g., `prepend`), " +
+					"but variables transformed by functions such as `upper` cannot be parsed.",
+				Optional: true,
+			},
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: "Whether `input` matches the format.",
+				Computed:            true,
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Why `input` does not match the format. `null` if it is valid.",
+				Computed:            true,
+			},
+			"words": schema.ListAttribute{
+				MarkdownDescription: "The words of a `proquint`, or the proquint words and random words of a `template`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"groups": schema.ListAttribute{
+				MarkdownDescription: "The dash-separated groups of a `nanoid` or `uuid`, or the values of the variables of a `template` in order of appearance.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"timestamp": schema.StringAttribute{
+				MarkdownDescription: "The embedded timestamp (RFC 3339) of a `ulid`, a `typeid`, a version 1 or 7 `uuid`, " +
+					"or of the `.typeid` of a `template`.",
+				Computed: true,
+			},
+			"parts": schema.MapAttribute{
+				MarkdownDescription: "Further named parts depending on the format:\n\n" +
+					"- `proquint` - `hex`\n" +
+					"- `nanoid` - `payload` and `check_character` (with `check_digit`)\n" +
+					"- `uuid` - `hex`, `version` and `variant`\n" +
+					"- `ulid` - `uuid` and `randomness` (hex)\n" +
+					"- `typeid` - `prefix` and `uuid`\n" +
+					"- `template` - the value of each variable by name",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ParseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider configuration is not needed for this implementation
+}
+
+func (d *ParseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ParseDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := data.Input.ValueString()
+	groupSize := int(data.GroupSize.ValueInt64())
+
+	var parsed parsedID
+	var err error
+
+	switch data.Format.ValueString() {
+	case parseFormatProquint:
+		parsed, err = parseProquintFormat(input, groupSize)
+	case parseFormatNanoID:
+		alphabet := idgen.Readable
+		if !data.Alphabet.IsNull() {
+			alphabet = resolveAlphabet(data.Alphabet.ValueString())
+		}
+
+		checkDigit := data.CheckDigit.ValueString()
+		if checkDigit != "" {
+			if err := idgen.ValidateCheckDigitAlgorithm(checkDigit, alphabet); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("check_digit"), "Invalid check digit", err.Error())
+				return
+			}
+		}
+
+		parsed, err = parseNanoIDFormat(input, alphabet, int(data.Length.ValueInt64()), groupSize, checkDigit)
+	case parseFormatUUID:
+		parsed, err = parseUUIDFormat(input)
+	case parseFormatULID:
+		parsed, err = parseULIDFormat(input)
+	case parseFormatTypeID:
+		parsed, err = parseTypeIDFormat(input)
+	case parseFormatTemplate:
+		if data.Template.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("template"),
+				"Missing template",
+				"The template attribute is required when format is 'template'.",
+			)
+			return
+		}
+
+		layout, names, layoutErr := compileTemplateLayout(data.Template.ValueString())
+		if layoutErr != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("template"), "Invalid template", layoutErr.Error())
+			return
+		}
+
+		parsed, err = parseTemplateFormat(input, layout, names)
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("format"),
+			"Invalid format",
+			fmt.Sprintf("Format '%s' is not supported. Valid formats are: proquint, nanoid, uuid, ulid, typeid, template.",
+				data.Format.ValueString()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(input)
+	data.Valid = types.BoolValue(err == nil)
+	data.Reason = types.StringNull()
+	data.Words = types.ListNull(types.StringType)
+	data.Groups = types.ListNull(types.StringType)
+	data.Timestamp = types.StringNull()
+	data.Parts = types.MapNull(types.StringType)
+
+	if err != nil {
+		data.Reason = types.StringValue(err.Error())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if parsed.words != nil {
+		words, diags := types.ListValueFrom(ctx, types.StringType, parsed.words)
+		resp.Diagnostics.Append(diags...)
+		data.Words = words
+	}
+	if parsed.groups != nil {
+		groups, diags := types.ListValueFrom(ctx, types.StringType, parsed.groups)
+		resp.Diagnostics.Append(diags...)
+		data.Groups = groups
+	}
+	if parsed.timestamp != nil {
+		data.Timestamp = types.StringValue(parsed.timestamp.Format(time.RFC3339Nano))
+	}
+	if parsed.parts != nil {
+		parts, diags := types.MapValueFrom(ctx, types.StringType, parsed.parts)
+		resp.Diagnostics.Append(diags...)
+		data.Parts = parts
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseProquintFormat checks that s is a lowercase proquint grouped like the output of the
+// proquint data source. A groupSize of 0 means one word per group.
+func parseProquintFormat(s string, groupSize int) (parsedID, error) {
+	decoded, err := idgen.DecodeProquint(s)
+	if err != nil {
+		return parsedID{}, err
+	}
+
+	canonical := idgen.FormatProquint(decoded)
+	expected := canonical
+	if groupSize > 0 {
+		expected = idgen.ApplyGrouping(strings.ReplaceAll(canonical, "-", ""), groupSize)
+	}
+	if s != expected {
+		return parsedID{}, fmt.Errorf("expected lowercase proquint words in dash-separated groups (%q), got %q", expected, s)
+	}
+
+	return parsedID{
+		words: strings.Split(canonical, "-"),
+		parts: map[string]string{"hex": hex.EncodeToString(decoded)},
+	}, nil
+}
+
+// parseNanoIDFormat checks s against the settings of the nanoid data source.
+func parseNanoIDFormat(s, alphabet string, length, groupSize int, checkDigit string) (parsedID, error) {
+	groups, err := idgen.ValidateNanoID(s, alphabet, length, groupSize, checkDigit)
+	if err != nil {
+		return parsedID{}, err
+	}
+
+	parsed := parsedID{groups: groups}
+	if checkDigit != "" {
+		payload := []rune(strings.Join(groups, ""))
+		parsed.parts = map[string]string{
+			"payload":         string(payload[:len(payload)-1]),
+			"check_character": string(payload[len(payload)-1:]),
+		}
+	}
+
+	return parsed, nil
+}
+
+// parseUUIDFormat checks that s is a UUID in canonical hyphenated form.
+func parseUUIDFormat(s string) (parsedID, error) {
+	if len(s) != 36 {
+		return parsedID{}, fmt.Errorf("expected a UUID in canonical hyphenated form (36 characters), got %d characters", len(s))
+	}
+
+	uuid, err := idgen.ParseUUID(s)
+	if err != nil {
+		return parsedID{}, err
+	}
+
+	var variant string
+	switch {
+	case uuid[8]&0x80 == 0:
+		variant = "ncs"
+	case uuid[8]&0xc0 == 0x80:
+		variant = "rfc9562"
+	case uuid[8]&0xe0 == 0xc0:
+		variant = "microsoft"
+	default:
+		variant = "future"
+	}
+
+	parsed := parsedID{
+		groups: strings.Split(s, "-"),
+		parts: map[string]string{
+			"hex":     hex.EncodeToString(uuid[:]),
+			"version": fmt.Sprint(idgen.UUIDVersion(uuid)),
+			"variant": variant,
+		},
+	}
+	if ts, ok := idgen.UUIDTimestamp(uuid); ok {
+		parsed.timestamp = &ts
+	}
+
+	return parsed, nil
+}
+
+// parseULIDFormat checks that s is a ULID.
+func parseULIDFormat(s string) (parsedID, error) {
+	id, ts, err := idgen.ParseULID(s)
+	if err != nil {
+		return parsedID{}, err
+	}
+
+	return parsedID{
+		timestamp: &ts,
+		parts: map[string]string{
+			"uuid":       idgen.FormatUUID(id),
+			"randomness": hex.EncodeToString(id[6:]),
+		},
+	}, nil
+}
+
+// parseTypeIDFormat checks that s is a TypeID.
+func parseTypeIDFormat(s string) (parsedID, error) {
+	prefix, uuidStr, err := idgen.DecodeTypeID(s)
+	if err != nil {
+		return parsedID{}, err
+	}
+
+	parsed := parsedID{
+		parts: map[string]string{
+			"prefix": prefix,
+			"uuid":   uuidStr,
+		},
+	}
+	uuid, _ := idgen.ParseUUID(uuidStr)
+	if ts, ok := idgen.UUIDTimestamp(uuid); ok {
+		parsed.timestamp = &ts
+	}
+
+	return parsed, nil
+}
+
+// compileTemplateLayout turns a template of the templated data source into an anchored
+// regular expression with one capture group per variable occurrence. The template is
+// executed with placeholders for the variables, so that functions adding text around a
+// variable are supported. It returns the variable name of each capture group.
+func compileTemplateLayout(layout string) (*regexp.Regexp, []string, error) {
+	rendered, err := renderTemplatePlaceholders(layout, templateComponentNames, nil, nil, types.StringNull())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pattern strings.Builder
+	var names []string
+	pattern.WriteString("^")
+	last := 0
+	for _, m := range templatePlaceholder.FindAllStringSubmatchIndex(rendered, -1) {
+		name := rendered[m[2]:m[3]]
+		componentPattern, ok := templateLayoutPatterns[name]
+		if !ok {
+			return nil, nil, fmt.Errorf("a template function transforms a variable into %q; "+
+				"only variables used as they are (optionally with text added around them) can be parsed", name)
+		}
+		pattern.WriteString(regexp.QuoteMeta(rendered[last:m[0]]))
+		pattern.WriteString("(" + componentPattern + ")")
+		names = append(names, name)
+		last = m[1]
+	}
+	if strings.Contains(rendered[last:], "\x00") {
+		return nil, nil, fmt.Errorf("a template function cuts a variable; " +
+			"only variables used as they are (optionally with text added around them) can be parsed")
+	}
+	pattern.WriteString(regexp.QuoteMeta(rendered[last:]))
+	pattern.WriteString("$")
+
+	if len(names) == 0 {
+		return nil, nil, fmt.Errorf("template must use at least one of the variables .proquint, .proquint_canonical, .nanoid, .random_word, .typeid or .cuid2")
+	}
+
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	return re, names, nil
+}
+
+// parseTemplateFormat matches s against a layout compiled by compileTemplateLayout.
+// A variable used several times must have the same value at each occurrence.
+func parseTemplateFormat(s string, layout *regexp.Regexp, names []string) (parsedID, error) {
+	match := layout.FindStringSubmatch(s)
+	if match == nil {
+		return parsedID{}, fmt.Errorf("%q does not match the template layout", s)
+	}
+
+	parsed := parsedID{
+		groups: match[1:],
+		parts:  make(map[string]string, len(names)),
+	}
+	for i, name := range names {
+		value := match[i+1]
+		if previous, ok := parsed.parts[name]; ok {
+			if previous != value {
+				return parsedID{}, fmt.Errorf("variable .%s has different values (%q and %q)", name, previous, value)
+			}
+			continue
+		}
+		parsed.parts[name] = value
+
+		switch name {
+		case "proquint", "proquint_canonical":
+			parsed.words = append(parsed.words, strings.Split(value, "-")...)
+		case "random_word":
+			parsed.words = append(parsed.words, value)
+		case "typeid":
+			typeID, err := parseTypeIDFormat(value)
+			if err != nil {
+				return parsedID{}, fmt.Errorf("variable .typeid (%q): %w", value, err)
+			}
+			if parsed.timestamp == nil {
+				parsed.timestamp = typeID.timestamp
+			}
+		}
+	}
+
+	return parsed, nil
+}
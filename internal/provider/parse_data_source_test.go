@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccParseDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_parse.proquint", "valid", "true"),
+					resource.TestCheckResourceAttr("data.idgen_parse.proquint", "words.#", "2"),
+					resource.TestCheckResourceAttr("data.idgen_parse.proquint", "words.0", "lusab"),
+					resource.TestCheckResourceAttr("data.idgen_parse.proquint", "parts.hex", "7f000001"),
+					resource.TestCheckNoResourceAttr("data.idgen_parse.proquint", "reason"),
+
+					resource.TestCheckResourceAttr("data.idgen_parse.nanoid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.idgen_parse.nanoid", "groups.#", "3"),
+					resource.TestCheckResourceAttr("data.idgen_parse.nanoid", "parts.check_character", "3"),
+
+					resource.TestCheckResourceAttr("data.idgen_parse.typo", "valid", "false"),
+					resource.TestCheckResourceAttr("data.idgen_parse.typo", "reason", "check character '4' does not match the preceding characters (damm)"),
+					resource.TestCheckNoResourceAttr("data.idgen_parse.typo", "groups.#"),
+
+					resource.TestCheckResourceAttr("data.idgen_parse.uuid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.idgen_parse.uuid", "timestamp", "2022-02-22T19:22:22Z"),
+					resource.TestCheckResourceAttr("data.idgen_parse.uuid", "parts.version", "7"),
+
+					resource.TestCheckResourceAttr("data.idgen_parse.ulid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.idgen_parse.ulid", "timestamp", "2016-07-30T23:54:10.259Z"),
+
+					resource.TestCheckResourceAttr("data.idgen_parse.typeid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.idgen_parse.typeid", "parts.prefix", "order"),
+				),
+			},
+			{
+				Config: testAccParseDataSourceConfigTemplate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_parse.test", "valid", "true"),
+					resource.TestCheckResourceAttr("data.idgen_parse.test", "parts.proquint", "lusab-babad"),
+					resource.TestCheckResourceAttr("data.idgen_parse.test", "parts.nanoid", "h84-Hs2-ML8-SW"),
+					resource.TestCheckResourceAttr("data.idgen_parse.test", "parts.random_word", "apple"),
+					resource.TestCheckResourceAttr("data.idgen_parse.test", "words.#", "3"),
+					resource.TestCheckResourceAttr("data.idgen_parse.test", "groups.#", "3"),
+				),
+			},
+			{
+				Config:      testAccParseDataSourceConfigInvalidFormat,
+				ExpectError: regexp.MustCompile(`Invalid format`),
+			},
+			{
+				Config:      testAccParseDataSourceConfigInvalidTemplate,
+				ExpectError: regexp.MustCompile(`Invalid template`),
+			},
+		},
+	})
+}
+
+const testAccParseDataSourceConfig = `
+data "idgen_parse" "proquint" {
+  input  = "lusab-babad"
+  format = "proquint"
+}
+
+data "idgen_parse" "nanoid" {
+  input       = "6365-9227-83"
+  format      = "nanoid"
+  alphabet    = "numeric"
+  length      = 12
+  group_size  = 4
+  check_digit = "damm"
+}
+
+data "idgen_parse" "typo" {
+  input       = "6365-9227-84"
+  format      = "nanoid"
+  alphabet    = "numeric"
+  length      = 12
+  group_size  = 4
+  check_digit = "damm"
+}
+
+data "idgen_parse" "uuid" {
+  input  = "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+  format = "uuid"
+}
+
+data "idgen_parse" "ulid" {
+  input  = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+  format = "ulid"
+}
+
+data "idgen_parse" "typeid" {
+  input  = "order_01h3s6spg0fj3vpm54wak4xnpp"
+  format = "typeid"
+}
+`
+
+const testAccParseDataSourceConfigTemplate = `
+data "idgen_parse" "test" {
+  input    = "lusab-babad.h84-Hs2-ML8-SW.apple"
+  format   = "template"
+  template = "{{ .proquint }}.{{ .nanoid }}.{{ .random_word }}"
+}
+`
+
+const testAccParseDataSourceConfigInvalidFormat = `
+data "idgen_parse" "test" {
+  input  = "abc"
+  format = "snowflake"
+}
+`
+
+const testAccParseDataSourceConfigInvalidTemplate = `
+data "idgen_parse" "test" {
+  input    = "ABC"
+  format   = "template"
+  template = "{{ .proquint | upper }}"
+}
+`
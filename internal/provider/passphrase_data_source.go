@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/iilei/terraform-provider-idgen/internal/data"
+	"github.com/iilei/terraform-provider-idgen/internal/idgen"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PassphraseDataSource{}
+
+func NewPassphraseDataSource() datasource.DataSource {
+	return &PassphraseDataSource{}
+}
+
+// PassphraseDataSource defines the data source implementation.
+type PassphraseDataSource struct{}
+
+// PassphraseDataSourceModel describes the data source data model.
+type PassphraseDataSourceModel struct {
+	ID          types.String  `tfsdk:"id"`
+	WordCount   types.Int64   `tfsdk:"word_count"`
+	Separator   types.String  `tfsdk:"separator"`
+	Case        types.String  `tfsdk:"case"`
+	Wordlist    types.String  `tfsdk:"wordlist"`
+	Seed        types.String  `tfsdk:"seed"`
+	EntropyBits types.Float64 `tfsdk:"entropy_bits"`
+}
+
+func (d *PassphraseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_passphrase"
+}
+
+func (d *PassphraseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a multi-word passphrase, e.g. `cadet-unsnap-dose-fraction-rerun-aqua`.\n\n" +
+			"Words are picked independently from the " +
+			"[EFF word lists](https://www.eff.org/deeplinks/2016/07/new-wordlists-random-passphrases) " +
+			"or a custom word list. The resulting entropy is reported in `entropy_bits`.\n\n" +
+			"**Security Notice:** When using `seed`, passphrases become deterministic and predictable. " +
+			"Never use seeded passphrases for passwords, tokens, or cryptographic purposes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The generated passphrase.",
+				Computed:    true,
+			},
+			"word_count": schema.Int64Attribute{
+				Description: "The number of words in the passphrase. Defaults to 6.",
+				Optional:    true,
+			},
+			"separator": schema.StringAttribute{
+				MarkdownDescription: "The separator placed between words. Defaults to `-`.",
+				Optional:            true,
+			},
+			"case": schema.StringAttribute{
+				MarkdownDescription: "The case of the words: `lower` (default), `upper` or `title` (first letter capitalized).",
+				Optional:            true,
+			},
+			"wordlist": schema.StringAttribute{
+				MarkdownDescription: "The word list to pick words from:\n\n" +
+					"- **`eff_large`** (default) - EFF large word list, 7776 words (~12.9 bits per word)\n" +
+					"- **`eff_short`** - EFF short word list 2.0, 1296 words with unique three-character prefixes (~10.3 bits per word)\n" +
+					"- **Custom** - a comma-separated string of words (e.g., `apple,banana,cherry,date`)",
+				Optional: true,
+			},
+			"seed": schema.StringAttribute{
+				MarkdownDescription: "Optional seed for deterministic word selection. Behavior:\n\n" +
+					"- **Integer** - parsed and used as random seed\n" +
+					"- **Text string** - hashed deterministically and used as random seed\n" +
+					"- **Omitted** - cryptographically random (different each apply)\n\n" +
+					"**WARNING:** Seeded passphrases are deterministic and should not be used for passwords or secrets.",
+				Optional: true,
+			},
+			"entropy_bits": schema.Float64Attribute{
+				MarkdownDescription: "The entropy of the passphrase in bits: `word_count * log2(word list size)`, rounded to two decimals. " +
+					"This assumes unseeded generation; a seeded passphrase is only as unpredictable as its seed.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *PassphraseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider configuration is not needed for this implementation
+}
+
+// resolvePassphraseWordlist returns the bundled word list for a preset name,
+// or the sorted, deduplicated words of a comma-separated custom list.
+func resolvePassphraseWordlist(input string) []string {
+	switch input {
+	case "", "eff_large":
+		return data.EFFLargeWords
+	case "eff_short":
+		return data.EFFShortWords
+	}
+
+	wordSet := make(map[string]struct{})
+	for _, word := range parseWordlist(input) {
+		wordSet[word] = struct{}{}
+	}
+
+	words := make([]string, 0, len(wordSet))
+	for word := range wordSet {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+
+	return words
+}
+
+func (d *PassphraseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PassphraseDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set defaults
+	wordCount := 6
+	if !data.WordCount.IsNull() {
+		wordCount = int(data.WordCount.ValueInt64())
+	}
+
+	if wordCount < 1 {
+		resp.Diagnostics.AddError(
+			"Invalid word_count",
+			"word_count must be at least 1",
+		)
+		return
+	}
+
+	separator := "-"
+	if !data.Separator.IsNull() {
+		separator = data.Separator.ValueString()
+	}
+
+	wordCase := idgen.PassphraseCaseLower
+	if !data.Case.IsNull() {
+		wordCase = data.Case.ValueString()
+	}
+
+	wordlist := resolvePassphraseWordlist(data.Wordlist.ValueString())
+	if len(wordlist) == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid wordlist",
+			"The custom word list does not contain any words.",
+		)
+		return
+	}
+
+	// Check if seed is provided
+	var seed *int64
+	if !data.Seed.IsNull() {
+		seedVal, _ := stringToSeed(data.Seed.ValueString())
+		seed = &seedVal
+	}
+
+	words, err := idgen.GeneratePassphrase(wordlist, wordCount, wordCase, seed)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to generate passphrase",
+			"Could not generate passphrase: "+err.Error(),
+		)
+		return
+	}
+
+	// Warn if a selected word contains the separator, as the word boundaries become ambiguous
+	if separator != "" {
+		for _, word := range words {
+			if strings.Contains(word, separator) {
+				resp.Diagnostics.AddWarning(
+					"Word contains separator",
+					fmt.Sprintf("The word '%s' contains the separator '%s'. "+
+						"This may cause confusion when reading the passphrase.", word, separator),
+				)
+				break
+			}
+		}
+	}
+
+	entropyBits := idgen.PassphraseEntropyBits(len(wordlist), wordCount)
+
+	data.ID = types.StringValue(strings.Join(words, separator))
+	data.EntropyBits = types.Float64Value(math.Round(entropyBits*100) / 100)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPassphraseDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPassphraseDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.idgen_passphrase.test", "id"),
+					resource.TestCheckResourceAttr("data.idgen_passphrase.test", "entropy_bits", "77.55"),
+				),
+			},
+			{
+				Config: testAccPassphraseDataSourceConfigSeeded,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_passphrase.test", "id", "prescribe-goldfish-pupil-mortality-vagabond-earlobe"),
+				),
+			},
+			{
+				Config: testAccPassphraseDataSourceConfigShort,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_passphrase.test", "id", "Nursery.Getaway.Omnivorous.Knickers"),
+					resource.TestCheckResourceAttr("data.idgen_passphrase.test", "entropy_bits", "41.36"),
+				),
+			},
+			{
+				Config: testAccPassphraseDataSourceConfigCustom,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_passphrase.test", "id", "RED_YELLOW_RED"),
+					resource.TestCheckResourceAttr("data.idgen_passphrase.test", "entropy_bits", "6"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPassphraseDataSourceConfig = `
+data "idgen_passphrase" "test" {}
+`
+
+const testAccPassphraseDataSourceConfigSeeded = `
+data "idgen_passphrase" "test" {
+  seed = "42"
+}
+`
+
+const testAccPassphraseDataSourceConfigShort = `
+data "idgen_passphrase" "test" {
+  word_count = 4
+  wordlist   = "eff_short"
+  separator  = "."
+  case       = "title"
+  seed       = "42"
+}
+`
+
+const testAccPassphraseDataSourceConfigCustom = `
+data "idgen_passphrase" "test" {
+  word_count = 3
+  wordlist   = "red,blue,green,yellow"
+  separator  = "_"
+  case       = "upper"
+  seed       = "42"
+}
+`
+
+func TestAccPassphraseDataSource_Invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPassphraseDataSourceConfigInvalidCount,
+				ExpectError: regexp.MustCompile("Invalid word_count"),
+			},
+			{
+				Config:      testAccPassphraseDataSourceConfigInvalidCase,
+				ExpectError: regexp.MustCompile("unsupported case"),
+			},
+		},
+	})
+}
+
+const testAccPassphraseDataSourceConfigInvalidCount = `
+data "idgen_passphrase" "test" {
+  word_count = 0
+}
+`
+
+const testAccPassphraseDataSourceConfigInvalidCase = `
+data "idgen_passphrase" "test" {
+  case = "camel"
+}
+`
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/iilei/terraform-provider-idgen/internal/idgen"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PetnameDataSource{}
+
+func NewPetnameDataSource() datasource.DataSource {
+	return &PetnameDataSource{}
+}
+
+// PetnameDataSource defines the data source implementation.
+type PetnameDataSource struct{}
+
+// PetnameDataSourceModel describes the data source data model.
+type PetnameDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	WordCount    types.Int64  `tfsdk:"word_count"`
+	Separator    types.String `tfsdk:"separator"`
+	Category     types.String `tfsdk:"category"`
+	SuffixDigits types.Int64  `tfsdk:"suffix_digits"`
+	Seed         types.String `tfsdk:"seed"`
+}
+
+func (d *PetnameDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_petname"
+}
+
+func (d *PetnameDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a Docker-style petname, e.g. `brave-otter` or `misty-falcon-42`.\n\n" +
+			"A petname consists of adjectives followed by an animal or noun, picked from small curated word lists " +
+			"that are professional, neutral and easily pronounceable. The lists are frozen, so seeded petnames stay stable.\n\n" +
+			"**Security Notice:** Petnames have little entropy and are meant as human-friendly names, " +
+			"not as unique or secret identifiers. When using `seed`, petnames are deterministic and predictable.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The generated petname.",
+				Computed:    true,
+			},
+			"word_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of words (1-4). All words but the last are distinct adjectives. Defaults to 2.",
+				Optional:            true,
+			},
+			"separator": schema.StringAttribute{
+				MarkdownDescription: "The separator placed between words and before the suffix. Defaults to `-`.",
+				Optional:            true,
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "The kind of the last word: `animal` (default, e.g. `brave-otter`) or `noun` (e.g. `misty-harbor`).",
+				Optional:            true,
+			},
+			"suffix_digits": schema.Int64Attribute{
+				MarkdownDescription: "The number of digits of a zero-padded numeric suffix (0-6), e.g. `2` for `misty-falcon-42`. " +
+					"Defaults to 0 (no suffix).",
+				Optional: true,
+			},
+			"seed": schema.StringAttribute{
+				MarkdownDescription: "Optional seed for deterministic generation. Behavior:\n\n" +
+					"- **Integer** - parsed and used as random seed\n" +
+					"- **Text string** - hashed deterministically and used as random seed\n" +
+					"- **Omitted** - cryptographically random (different each apply)",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *PetnameDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider configuration is not needed for this implementation
+}
+
+func (d *PetnameDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PetnameDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set defaults
+	wordCount := 2
+	if !data.WordCount.IsNull() {
+		wordCount = int(data.WordCount.ValueInt64())
+	}
+
+	separator := "-"
+	if !data.Separator.IsNull() {
+		separator = data.Separator.ValueString()
+	}
+
+	category := idgen.PetnameCategoryAnimal
+	if !data.Category.IsNull() {
+		category = data.Category.ValueString()
+	}
+
+	suffixDigits := int(data.SuffixDigits.ValueInt64())
+
+	// Check if seed is provided
+	var seed *int64
+	if !data.Seed.IsNull() {
+		seedVal, _ := stringToSeed(data.Seed.ValueString())
+		seed = &seedVal
+	}
+
+	petname, err := idgen.GeneratePetname(wordCount, category, separator, suffixDigits, seed)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to generate petname",
+			"Could not generate petname: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(petname)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
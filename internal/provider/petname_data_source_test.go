@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPetnameDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPetnameDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.idgen_petname.test", "id", regexp.MustCompile(`^[a-z]+-[a-z]+$`)),
+				),
+			},
+			{
+				Config: testAccPetnameDataSourceConfigSeeded,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_petname.test", "id", "polite-zebra"),
+				),
+			},
+			{
+				Config: testAccPetnameDataSourceConfigNoun,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_petname.test", "id", "earnest_smart_willow_78"),
+				),
+			},
+			{
+				Config:      testAccPetnameDataSourceConfigInvalid,
+				ExpectError: regexp.MustCompile(`word count must be between 1 and 4`),
+			},
+		},
+	})
+}
+
+const testAccPetnameDataSourceConfig = `
+data "idgen_petname" "test" {}
+`
+
+const testAccPetnameDataSourceConfigSeeded = `
+data "idgen_petname" "test" {
+  seed = "42"
+}
+`
+
+const testAccPetnameDataSourceConfigNoun = `
+data "idgen_petname" "test" {
+  word_count    = 3
+  category      = "noun"
+  separator     = "_"
+  suffix_digits = 2
+  seed          = "env-7"
+}
+`
+
+const testAccPetnameDataSourceConfigInvalid = `
+data "idgen_petname" "test" {
+  word_count = 5
+}
+`
@@ -2,13 +2,10 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/iilei/terraform-provider-idgen/internal/idgen"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -33,19 +30,27 @@ func (d *ProquintCanonicalDataSource) Metadata(ctx context.Context, req datasour
 
 func (d *ProquintCanonicalDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Generates a canonical Proquint identifier from an IPv4 address or unsigned integer.\n\n" +
+		MarkdownDescription: "Generates a canonical Proquint identifier from an IP address, CIDR block, MAC address, UUID, hex string or unsigned integer.\n\n" +
 			"This data source implements the canonical proquint encoding as described in the " +
 			"[original specification](https://arxiv.org/html/0901.4016). " +
-			"It directly encodes the provided value as a proquint.\n\n" +
+			"It directly encodes the provided value as a proquint, one word per 16 bits.\n\n" +
 			"**Output Length:**\n\n" +
 			"- **32-bit values** (IPv4, uint32 0-4294967295): 11 characters (2 proquint words)\n" +
-			"- **64-bit values** (uint64 4294967296+): 23 characters (4 proquint words)\n\n" +
-			"**Limitations:**\n\n" +
-			"- **IPv6 not supported**: The original proquint specification focuses on 32-bit values. " +
-			"IPv6 addresses (128-bit) must be manually converted to their integer representation before encoding.\n\n" +
+			"- **48-bit values** (MAC addresses, IPv4 CIDR blocks): 17 characters (3 proquint words)\n" +
+			"- **64-bit values** (uint64 4294967296+, IPv6 /64 networks): 23 characters (4 proquint words)\n" +
+			"- **128-bit values** (IPv6, UUIDs, uint128): 47 characters (8 proquint words)\n" +
+			"- **Hex byte strings** longer than 16 digits: one word per 4 hex digits (up to 64 bytes / 32 words)\n\n" +
+			"**CIDR Layout:**\n\n" +
+			"- **IPv4** (e.g., `10.0.0.0/16`~>`bomab-babab-babib`): the address as written (2 words) followed by " +
+			"one word holding the prefix length (0-32). Host bits are kept, so `192.168.1.10/24` round-trips unchanged.\n" +
+			"- **IPv6** (e.g., `2001:db8:0:1::/64`~>`fabad-bukum-babab-babad`): the network prefix only, one word per 16 bits. " +
+			"The prefix length must be a multiple of 16 and is implied by the number of words; host bits are dropped.\n\n" +
+			"The encoding does not record the type of the value: an IPv4 CIDR block and a MAC address both take 3 words, " +
+			"so [proquint_decode](./proquint_decode) returns both interpretations and the caller picks the one it encoded.\n\n" +
 			"**Use Cases:**\n\n" +
 			"- Convert IP addresses to memorable identifiers\n" +
-			"- Encode integer values as human-readable proquints\n" +
+			"- Give memorable names to subnets and network interfaces (IPv4/IPv6 CIDR blocks, MAC addresses)\n" +
+			"- Encode integer values, UUIDs or hashes as human-readable proquints\n" +
 			"- Generate deterministic identifiers from numeric data\n\n" +
 			"**Security Notice:** Canonical proquints are deterministic encodings of the input value. " +
 			"They should not be used for security tokens or secrets.",
@@ -54,24 +59,34 @@ func (d *ProquintCanonicalDataSource) Schema(ctx context.Context, req datasource
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The generated canonical Proquint identifier. Length varies by input:\n\n" +
 					"- 11 characters for 32-bit values (IPv4, uint32)\n" +
-					"- 23 characters for 64-bit values (uint64)",
+					"- 17 characters for 48-bit values (MAC addresses, IPv4 CIDR blocks)\n" +
+					"- 23 characters for 64-bit values (uint64, IPv6 /64 networks)\n" +
+					"- 47 characters for 128-bit values (IPv6, UUIDs, uint128)\n" +
+					"- 6 characters per 16 bits (minus the trailing dash) for longer hex byte strings",
 				Computed: true,
 			},
 			"seed": schema.StringAttribute{
 				MarkdownDescription: "The seed value to encode as a proquint. Accepts:\n\n" +
 					"- **IPv4 address** (e.g., `127.0.0.1`)~>11 chars\n" +
-					"- **Hexadecimal string** (e.g., `0x7f000001` or `7f000001`)~>11 or 23 chars\n" +
+					"- **IPv6 address** (e.g., `2001:db8::1`)~>47 chars\n" +
+					"- **IPv4 CIDR block** (e.g., `10.0.0.0/16`)~>17 chars (address + prefix length)\n" +
+					"- **IPv6 network** with a prefix length that is a multiple of 16 (e.g., `2001:db8:0:1::/64`)~>" +
+					"one word per 16 prefix bits; host bits are ignored\n" +
+					"- **MAC address** (e.g., `aa:bb:cc:dd:ee:ff`, `aa-bb-cc-dd-ee-ff` or `aabb.ccdd.eeff`)~>17 chars\n" +
+					"- **UUID** (e.g., `0188f26c-da00-72b4-a6e4-adc2899eff1f`)~>47 chars\n" +
+					"- **Hexadecimal string** (e.g., `0x7f000001` or `7f000001`)~>11 or 23 chars. " +
+					"Strings longer than 16 digits are encoded byte for byte, keeping leading zeros and left-padding to whole 16-bit words\n" +
 					"- **uint32 integer** (0-4294967295)~>11 chars\n" +
-					"- **uint64 integer** (4294967296-18446744073709551615)~>23 chars\n\n" +
-					"**Note:** IPv6 addresses are not supported directly. The canonical encoding follows the original " +
-					"specification which focuses on 32-bit values. To encode IPv6 (128-bit), convert it to its " +
-					"integer representation first.\n\n" +
+					"- **uint64 integer** (4294967296-18446744073709551615)~>23 chars\n" +
+					"- **uint128 integer** (18446744073709551616-340282366920938463463374607431768211455)~>47 chars\n\n" +
 					"Examples:\n" +
 					"- `127.0.0.1`~>`lusab-babad` (11 chars)\n" +
 					"- `0x7f000001`~>`lusab-babad` (11 chars, hex format)\n" +
 					"- `2130706433`~>`lusab-babad` (11 chars, decimal)\n" +
 					"- `0xffffffff`~>`zuzuz-zuzuz` (11 chars, max uint32)\n" +
-					"- `0x7fffffffffffffff`~>`luzuz-zuzuz-zuzuz-zuzuz` (23 chars, max int64)",
+					"- `0x7fffffffffffffff`~>`luzuz-zuzuz-zuzuz-zuzuz` (23 chars, max int64)\n" +
+					"- `aa:bb:cc:dd:ee:ff`~>`popur-sugit-vuruz` (17 chars, MAC)\n" +
+					"- `::1`~>`babab-babab-babab-babab-babab-babab-babab-babad` (47 chars, IPv6)",
 				Required: true,
 			},
 		},
@@ -91,35 +106,8 @@ func (d *ProquintCanonicalDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
-	// Parse the seed and check if it's valid for canonical encoding
-	value, _, errMsg := stringToCanonicalValue(data.Seed.ValueString())
-
-	if errMsg != "" {
-		resp.Diagnostics.AddError(
-			"Invalid seed for canonical encoding",
-			fmt.Sprintf(
-				"The seed '%s' cannot be canonically encoded as a proquint.\n\n"+
-					"Error: %s\n\n"+
-					"Canonical encoding accepts:\n"+
-					"  - IPv4 addresses (e.g., 127.0.0.1)~>11 chars\n"+
-					"  - Hexadecimal strings (e.g., 0x7f000001 or 7f000001)~>11 or 23 chars\n"+
-					"  - Unsigned integers 0-4294967295~>11 chars\n"+
-					"  - Unsigned integers 4294967296-18446744073709551615~>23 chars\n\n"+
-					"For generating proquint-formatted IDs from arbitrary strings, use the 'idgen_proquint' data source instead.",
-				data.Seed.ValueString(),
-				errMsg,
-			),
-		)
-		return
-	}
-
-	// Generate the canonical proquint
-	id, err := idgen.GenerateCanonicalProquint(value)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to generate canonical Proquint",
-			"Could not generate Proquint: "+err.Error(),
-		)
+	id := generateProquintCanonical(ProquintCanonicalConfig{Seed: data.Seed, GroupSize: types.Int64Null()}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
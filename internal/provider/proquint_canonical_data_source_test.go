@@ -145,6 +145,51 @@ func TestAccProquintCanonicalDataSource_Hexadecimal(t *testing.T) {
 	})
 }
 
+func TestAccProquintCanonicalDataSource_ExtendedFormats(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProquintCanonicalDataSourceConfig_IPv4("::1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_canonical.test", "id", "babab-babab-babab-babab-babab-babab-babab-babad"),
+				),
+			},
+			{
+				Config: testAccProquintCanonicalDataSourceConfig_IPv4("2001:db8:0:1::/64"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_canonical.test", "id", "fabad-bukum-babab-babad"),
+				),
+			},
+			{
+				Config: testAccProquintCanonicalDataSourceConfig_IPv4("0188f26c-da00-72b4-a6e4-adc2899eff1f"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_canonical.test", "id", "bakam-zanos-tomab-lapuh-piroh-pulaf-mokiv-zusiz"),
+				),
+			},
+			{
+				Config: testAccProquintCanonicalDataSourceConfig_IPv4("aa:bb:cc:dd:ee:ff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_canonical.test", "id", "popur-sugit-vuruz"),
+				),
+			},
+			{
+				Config: testAccProquintCanonicalDataSourceConfig_IPv4("10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_canonical.test", "id", "bomab-babab-babib"),
+				),
+			},
+			{
+				Config: testAccProquintCanonicalDataSourceConfig_Uint32("340282366920938463463374607431768211455"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_canonical.test", "id", "zuzuz-zuzuz-zuzuz-zuzuz-zuzuz-zuzuz-zuzuz-zuzuz"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccProquintCanonicalDataSource_InvalidValue(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -155,11 +200,12 @@ func TestAccProquintCanonicalDataSource_InvalidValue(t *testing.T) {
 				ExpectError: regexp.MustCompile("Invalid seed for canonical encoding"),
 			},
 			{
-				Config:      testAccProquintCanonicalDataSourceConfig_Uint32("99999999999999999999"),
+				// 2^128 exceeds the uint128 range
+				Config:      testAccProquintCanonicalDataSourceConfig_Uint32("340282366920938463463374607431768211456"),
 				ExpectError: regexp.MustCompile("Invalid seed for canonical encoding"),
 			},
 			{
-				Config:      testAccProquintCanonicalDataSourceConfig_IPv4("2001:db8::1"),
+				Config:      testAccProquintCanonicalDataSourceConfig_IPv4("2001:db8::/56"),
 				ExpectError: regexp.MustCompile("Invalid seed for canonical encoding"),
 			},
 		},
@@ -2,14 +2,10 @@ package provider
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/iilei/terraform-provider-idgen/internal/idgen"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -28,6 +24,9 @@ type ProquintDataSourceModel struct {
 	Length    types.Int64  `tfsdk:"length"`
 	GroupSize types.Int64  `tfsdk:"group_size"`
 	Seed      types.String `tfsdk:"seed"`
+
+	Spoken           types.String `tfsdk:"spoken"`
+	SpellingAlphabet types.String `tfsdk:"spelling_alphabet"`
 }
 
 func (d *ProquintDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -64,6 +63,15 @@ func (d *ProquintDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 					"**WARNING:** Seeded IDs are deterministic and should not be used for security tokens or secrets.",
 				Optional: true,
 			},
+			"spoken": schema.StringAttribute{
+				MarkdownDescription: "The Proquint spelled out letter by letter, e.g. `lima-uniform-sierra-alfa-bravo-dash-...` " +
+					"for `lusab-...`. Useful where words are easily misheard, such as over a poor phone line.",
+				Computed: true,
+			},
+			"spelling_alphabet": schema.StringAttribute{
+				MarkdownDescription: spellingAlphabetDescription,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -82,81 +90,18 @@ func (d *ProquintDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	// Length is now required (no default)
-	length := data.Length.ValueInt64()
-
-	// Validate length
-	if !validateLength(length, &resp.Diagnostics) {
+	// Length is required, so the default of templated components does not apply
+	id := generateProquint(ProquintConfig{Length: data.Length, Seed: data.Seed, GroupSize: data.GroupSize}, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Convert character length to byte length
-	// Proquint: 2 bytes = 1 word (5 chars), separator between words
-	// Approximate: (length + 1) / 6 * 2 bytes
-	byteLength := int((length + 1) / 6 * 2)
-	if byteLength < 2 {
-		byteLength = 2 // Minimum 1 word
-	}
-
-	// Check if seed is provided
-	var seed *int64
-	var directEncode bool
-	if !data.Seed.IsNull() {
-		seedVal, shouldDirectEncode := stringToSeed(data.Seed.ValueString())
-		seed = &seedVal
-		directEncode = shouldDirectEncode
-
-		// Warn if using direct encoding with non-canonical length
-		if shouldDirectEncode {
-			// Determine what the canonical length would be
-			canonicalLength := int64(11) // default for uint32
-			if seedVal > 0xFFFFFFFF {
-				canonicalLength = 23 // uint64 range
-			}
-
-			if length != canonicalLength {
-				resp.Diagnostics.AddWarning(
-					"Non-Canonical Length for Direct Encoding",
-					fmt.Sprintf(
-						"The seed value '%s' will be canonically encoded to %d characters, but length=%d was requested. "+
-							"The output will be %s to match your requested length. "+
-							"Consider using idgen_proquint_canonical for canonical encoding without specifying length, "+
-							"or adjust length to %d for the standard canonical output.",
-						data.Seed.ValueString(),
-						canonicalLength,
-						length,
-						map[bool]string{true: "truncated", false: "zero-padded"}[length < canonicalLength],
-						canonicalLength,
-					),
-				)
-			}
-		}
-	}
-
-	// Generate the Proquint
-	id, err := idgen.GenerateProquint(byteLength, seed, directEncode)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to generate Proquint",
-			"Could not generate Proquint: "+err.Error(),
-		)
+	data.ID = types.StringValue(id)
+	data.Spoken = spellID(id, data.SpellingAlphabet, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Determine group size (default to 5 for standard proquint format)
-	groupSize := 5
-	if !data.GroupSize.IsNull() {
-		groupSize = int(data.GroupSize.ValueInt64())
-	}
-
-	// Remove all dashes and apply grouping
-	if groupSize > 0 {
-		id = strings.ReplaceAll(id, "-", "")
-		id = idgen.ApplyGrouping(id, groupSize)
-	}
-
-	data.ID = types.StringValue(id)
-
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -32,6 +32,8 @@ func TestAccProquintDataSource(t *testing.T) {
 				Config: testAccProquintDataSourceConfigSeeded,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.idgen_proquint.test", "id", "lufuh-fumod-tagan"),
+					resource.TestCheckResourceAttr("data.idgen_proquint.test", "spoken",
+						"lima-uniform-foxtrot-uniform-hotel-dash-foxtrot-uniform-mike-oscar-delta-dash-tango-alfa-golf-alfa-november"),
 				),
 			},
 			// Test with group_size (unseeded should produce different results)
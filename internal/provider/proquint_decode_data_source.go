@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/iilei/terraform-provider-idgen/internal/idgen"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProquintDecodeDataSource{}
+
+func NewProquintDecodeDataSource() datasource.DataSource {
+	return &ProquintDecodeDataSource{}
+}
+
+// ProquintDecodeDataSource defines the data source implementation.
+type ProquintDecodeDataSource struct{}
+
+// ProquintDecodeDataSourceModel describes the data source data model.
+type ProquintDecodeDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Proquint types.String `tfsdk:"proquint"`
+	Hex      types.String `tfsdk:"hex"`
+	Integer  types.String `tfsdk:"integer"`
+	IPv4     types.String `tfsdk:"ipv4"`
+	IPv4CIDR types.String `tfsdk:"ipv4_cidr"`
+	IPv6     types.String `tfsdk:"ipv6"`
+	IPv6CIDR types.String `tfsdk:"ipv6_cidr"`
+	MAC      types.String `tfsdk:"mac"`
+	UUID     types.String `tfsdk:"uuid"`
+}
+
+func (d *ProquintDecodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proquint_decode"
+}
+
+func (d *ProquintDecodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Decodes a Proquint back into the value it encodes, e.g. `lusab-babad`~>`127.0.0.1`.\n\n" +
+			"This is the inverse of [proquint_canonical](./proquint_canonical) and [proquint](./proquint): " +
+			"each five-letter word is validated and decoded to 16 bits. " +
+			"The result is always returned as `hex`; the other attributes are only set when the width matches " +
+			"and are `null` otherwise.\n\n" +
+			"A proquint does not record the type of the value it encodes. Widths shared by several types set every " +
+			"matching attribute, and the caller must pick the interpretation it encoded: 3 words are both a `mac` and, " +
+			"if the last word is at most 32, an `ipv4_cidr` (`aa:bb:cc:dd:00:18` also decodes to `170.187.204.221/24`); " +
+			"8 words are both an `ipv6` address and a `uuid`.\n\n" +
+			"**Input Format:**\n\n" +
+			"- Letters are matched case-insensitively\n" +
+			"- Any other character is treated as a separator, so any grouping works " +
+			"(`lusab-babad`, `lusabbabad` and `lusa-bbab-ad` decode the same)\n" +
+			"- Invalid words are reported with their number and the position of the offending character",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The normalized proquint: lowercase words joined by `-`.",
+				Computed:            true,
+			},
+			"proquint": schema.StringAttribute{
+				MarkdownDescription: "The proquint to decode (e.g., `lusab-babad`).",
+				Required:            true,
+			},
+			"hex": schema.StringAttribute{
+				MarkdownDescription: "The decoded bytes as lowercase hexadecimal, 4 hex digits per word.",
+				Computed:            true,
+			},
+			"integer": schema.StringAttribute{
+				MarkdownDescription: "The decoded value as an unsigned decimal integer, for up to 8 words (128 bits). " +
+					"Returned as a string so large values keep their precision; use `tonumber()` for values up to 2^53.",
+				Computed: true,
+			},
+			"ipv4": schema.StringAttribute{
+				MarkdownDescription: "The decoded IPv4 address, for 2 words (32 bits).",
+				Computed:            true,
+			},
+			"ipv4_cidr": schema.StringAttribute{
+				MarkdownDescription: "The decoded IPv4 CIDR block, for 3 words where the last word is a prefix length (0-32). " +
+					"MAC addresses ending in `00:00` to `00:20` decode to a CIDR block too; see `mac`. " +
+					"See the CIDR layout of [proquint_canonical](./proquint_canonical).",
+				Computed: true,
+			},
+			"ipv6": schema.StringAttribute{
+				MarkdownDescription: "The decoded IPv6 address, for 8 words (128 bits).",
+				Computed:            true,
+			},
+			"ipv6_cidr": schema.StringAttribute{
+				MarkdownDescription: "The decoded IPv6 network, for 1 to 7 words. The prefix length is 16 bits per word, " +
+					"e.g. 4 words~>`/64`.",
+				Computed: true,
+			},
+			"mac": schema.StringAttribute{
+				MarkdownDescription: "The decoded MAC address (e.g., `aa:bb:cc:dd:ee:ff`), for 3 words (48 bits). " +
+					"IPv4 CIDR blocks have the same width and decode to a MAC address too; see `ipv4_cidr`.",
+				Computed: true,
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "The decoded UUID in canonical hyphenated form, for 8 words (128 bits).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ProquintDecodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider configuration is not needed for this implementation
+}
+
+func (d *ProquintDecodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProquintDecodeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bytes, err := idgen.DecodeProquint(data.Proquint.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proquint"),
+			"Invalid proquint",
+			fmt.Sprintf("The value '%s' is not a valid proquint: %s.\n\n"+
+				"Each word must have the form consonant-vowel-consonant-vowel-consonant, "+
+				"using the consonants bdfghjklmnprstvz and the vowels aiou.",
+				data.Proquint.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(idgen.FormatProquint(bytes))
+	data.Hex = types.StringValue(hex.EncodeToString(bytes))
+
+	// Interpretations that depend on the width are null unless the width matches
+	data.Integer = types.StringNull()
+	data.IPv4 = types.StringNull()
+	data.IPv4CIDR = types.StringNull()
+	data.IPv6 = types.StringNull()
+	data.IPv6CIDR = types.StringNull()
+	data.MAC = types.StringNull()
+	data.UUID = types.StringNull()
+
+	if len(bytes) <= 16 {
+		data.Integer = types.StringValue(new(big.Int).SetBytes(bytes).String())
+	}
+
+	switch len(bytes) {
+	case 4:
+		data.IPv4 = types.StringValue(net.IP(bytes).String())
+	case 6:
+		data.MAC = types.StringValue(net.HardwareAddr(bytes).String())
+		if prefix := binary.BigEndian.Uint16(bytes[4:]); prefix <= 32 {
+			data.IPv4CIDR = types.StringValue(fmt.Sprintf("%s/%d", net.IP(bytes[:4]), prefix))
+		}
+	case 16:
+		data.IPv6 = types.StringValue(net.IP(bytes).String())
+		data.UUID = types.StringValue(idgen.FormatUUID([16]byte(bytes)))
+	}
+
+	if len(bytes) < 16 {
+		network := net.IPNet{IP: make(net.IP, net.IPv6len), Mask: net.CIDRMask(len(bytes)*8, 128)}
+		copy(network.IP, bytes)
+		data.IPv6CIDR = types.StringValue(network.String())
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
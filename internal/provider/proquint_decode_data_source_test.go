@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProquintDecodeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProquintDecodeDataSourceConfig("Lusa-Bbab-ad"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "id", "lusab-babad"),
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "hex", "7f000001"),
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "integer", "2130706433"),
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "ipv4", "127.0.0.1"),
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "ipv6_cidr", "7f00:1::/32"),
+					resource.TestCheckNoResourceAttr("data.idgen_proquint_decode.test", "ipv6"),
+					resource.TestCheckNoResourceAttr("data.idgen_proquint_decode.test", "mac"),
+				),
+			},
+			{
+				Config: testAccProquintDecodeDataSourceConfig("bomab-babab-babib"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "ipv4_cidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "mac", "0a:00:00:00:00:10"),
+					resource.TestCheckNoResourceAttr("data.idgen_proquint_decode.test", "ipv4"),
+				),
+			},
+			// A MAC address whose last word is a valid prefix length is ambiguous
+			{
+				Config: testAccProquintDecodeDataSourceConfig("popur-sugit-babim"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "mac", "aa:bb:cc:dd:00:18"),
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "ipv4_cidr", "170.187.204.221/24"),
+				),
+			},
+			{
+				Config: testAccProquintDecodeDataSourceConfig("popur-sugit-vuruz"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "mac", "aa:bb:cc:dd:ee:ff"),
+					resource.TestCheckNoResourceAttr("data.idgen_proquint_decode.test", "ipv4_cidr"),
+				),
+			},
+			{
+				Config: testAccProquintDecodeDataSourceConfig("fabad-bukum-babab-babad"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "ipv6_cidr", "2001:db8:0:1::/64"),
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "integer", "2306139568115548161"),
+				),
+			},
+			{
+				Config: testAccProquintDecodeDataSourceConfig("babab-babab-babab-babab-babab-babab-babab-babad"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "ipv6", "::1"),
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "uuid", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "integer", "1"),
+					resource.TestCheckNoResourceAttr("data.idgen_proquint_decode.test", "ipv6_cidr"),
+				),
+			},
+			// Longer than canonical encoding supports (33 words)
+			{
+				Config: testAccProquintDecodeDataSourceConfig(strings.Repeat("babab-", 32) + "babad"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "id", strings.Repeat("babab-", 32)+"babad"),
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "hex", strings.Repeat("0", 130)+"01"),
+					resource.TestCheckNoResourceAttr("data.idgen_proquint_decode.test", "integer"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProquintDecodeDataSource_Canonical(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProquintDecodeDataSourceConfig_RoundTrip,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_proquint_decode.test", "ipv4_cidr", "192.168.1.10/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProquintDecodeDataSource_Invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProquintDecodeDataSourceConfig("lusab-babed"),
+				ExpectError: regexp.MustCompile(`Invalid proquint`),
+			},
+			{
+				Config:      testAccProquintDecodeDataSourceConfig("lusab-bab"),
+				ExpectError: regexp.MustCompile(`Invalid proquint`),
+			},
+		},
+	})
+}
+
+func testAccProquintDecodeDataSourceConfig(value string) string {
+	return `
+data "idgen_proquint_decode" "test" {
+  proquint = "` + value + `"
+}
+`
+}
+
+const testAccProquintDecodeDataSourceConfig_RoundTrip = `
+data "idgen_proquint_canonical" "subnet" {
+  seed = "192.168.1.10/24"
+}
+
+data "idgen_proquint_decode" "test" {
+  proquint = data.idgen_proquint_canonical.subnet.id
+}
+`
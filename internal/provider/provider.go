@@ -55,6 +55,16 @@ func (p *IdgenProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		NewProquintCanonicalDataSource,
 		NewRandomWordDataSource,
 		NewTemplatedDataSource,
+		NewTypeIDDataSource,
+		NewCUID2DataSource,
+		NewMnemonicDataSource,
+		NewMnemonicDecodeDataSource,
+		NewPassphraseDataSource,
+		NewPetnameDataSource,
+		NewProquintDecodeDataSource,
+		NewWordEncodeDataSource,
+		NewWordDecodeDataSource,
+		NewParseDataSource,
 	}
 }
 
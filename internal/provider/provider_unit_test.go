@@ -57,7 +57,7 @@ func TestIdgenProvider_DataSources(t *testing.T) {
 	dataSources := p.DataSources(context.Background())
 
 	// Should return all data sources
-	expectedCount := 5 // nanoid, proquint, proquint_canonical, random_word, templated
+	expectedCount := 15 // nanoid, proquint, proquint_canonical, random_word, templated, typeid, cuid2, mnemonic, mnemonic_decode, passphrase, petname, proquint_decode, word_encode, word_decode, parse
 	if len(dataSources) != expectedCount {
 		t.Errorf("DataSources() should return %d data sources, got %d", expectedCount, len(dataSources))
 	}
@@ -7,8 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/iilei/terraform-provider-idgen/internal/idgen"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -95,21 +93,7 @@ func (d *RandomWordDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	var seed string
-	if data.Seed.IsNull() {
-		seed = ""
-	} else {
-		seed = data.Seed.ValueString()
-	}
-
-	var wordlist []string
-
-	if !data.Wordlist.IsNull() {
-		wordlist = parseWordlist(data.Wordlist.ValueString())
-	}
-
-	// Generate the RandomWord
-	id := idgen.GetWordBySeed(seed, wordlist)
+	id := generateRandomWord(RandomWordConfig{Seed: data.Seed, Wordlist: data.Wordlist})
 
 	data.ID = types.StringValue(id)
 
@@ -4,12 +4,17 @@ import (
 	"bytes"
 	"context"
 	_ "embed"
+	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"text/template"
+	"text/template/parse"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
@@ -21,6 +26,7 @@ var templateFunctionsDocs string
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &TemplatedDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &TemplatedDataSource{}
 
 func NewTemplatedDataSource() datasource.DataSource {
 	return &TemplatedDataSource{}
@@ -33,37 +39,67 @@ type TemplatedDataSource struct{}
 type TemplatedDataSourceModel struct {
 	ID                types.String `tfsdk:"id"`
 	Template          types.String `tfsdk:"template"`
+	Seed              types.String `tfsdk:"seed"`
+	BatchSize         types.Int64  `tfsdk:"batch_size"`
+	IDs               types.List   `tfsdk:"ids"`
+	Templates         types.Map    `tfsdk:"templates"`
+	Outputs           types.Map    `tfsdk:"outputs"`
+	Parts             types.Map    `tfsdk:"parts"`
 	Proquint          types.Object `tfsdk:"proquint"`
 	ProquintCanonical types.Object `tfsdk:"proquint_canonical"`
 	NanoID            types.Object `tfsdk:"nanoid"`
 	RandomWord        types.Object `tfsdk:"random_word"`
-}
+	TypeID            types.Object `tfsdk:"typeid"`
+	CUID2             types.Object `tfsdk:"cuid2"`
+	Components        types.Map    `tfsdk:"components"`
+	Vars              types.Map    `tfsdk:"vars"`
 
-// ProquintConfig holds configuration for proquint generation
-type ProquintConfig struct {
-	Length    types.Int64  `tfsdk:"length"`
-	Seed      types.String `tfsdk:"seed"`
-	GroupSize types.Int64  `tfsdk:"group_size"`
-}
+	Spoken           types.String `tfsdk:"spoken"`
+	SpellingAlphabet types.String `tfsdk:"spelling_alphabet"`
+
+	NamingProfile types.String `tfsdk:"naming_profile"`
+	NamingMode    types.String `tfsdk:"naming_mode"`
 
-// ProquintCanonicalConfig holds configuration for canonical proquint generation
-type ProquintCanonicalConfig struct {
-	Seed      types.String `tfsdk:"seed"`
-	GroupSize types.Int64  `tfsdk:"group_size"`
+	MaxLength        types.Int64  `tfsdk:"max_length"`
+	TruncateStrategy types.String `tfsdk:"truncate_strategy"`
 }
 
-// NanoIDConfig holds configuration for nanoid generation
-type NanoIDConfig struct {
-	Length    types.Int64  `tfsdk:"length"`
-	Seed      types.String `tfsdk:"seed"`
-	GroupSize types.Int64  `tfsdk:"group_size"`
-	Alphabet  types.String `tfsdk:"alphabet"`
+// Modes of the naming_profile attribute.
+const (
+	namingModeValidate = "validate"
+	namingModeAdapt    = "adapt"
+)
+
+// Strategies of the max_length attribute.
+const (
+	truncateStrategyHash  = "hash"
+	truncateStrategyCut   = "cut"
+	truncateStrategyError = "error"
+)
+
+// TemplateComponentConfig holds configuration for an entry of the components map.
+// It combines the attributes of all component types; type selects the generator.
+type TemplateComponentConfig struct {
+	Type       types.String `tfsdk:"type"`
+	Seed       types.String `tfsdk:"seed"`
+	Length     types.Int64  `tfsdk:"length"`
+	GroupSize  types.Int64  `tfsdk:"group_size"`
+	Alphabet   types.String `tfsdk:"alphabet"`
+	CheckDigit types.String `tfsdk:"check_digit"`
+	Wordlist   types.String `tfsdk:"wordlist"`
+	Prefix     types.String `tfsdk:"prefix"`
+	Timestamp  types.String `tfsdk:"timestamp"`
 }
 
-// RandomWordConfig holds configuration for random word generation
-type RandomWordConfig struct {
-	Seed     types.String `tfsdk:"seed"`
-	Wordlist types.String `tfsdk:"wordlist"`
+// templateComponentAttributes lists the attributes each component type supports
+// in the components map.
+var templateComponentAttributes = map[string][]string{
+	"proquint":           {"seed", "length", "group_size"},
+	"proquint_canonical": {"seed", "group_size"},
+	"nanoid":             {"seed", "length", "group_size", "alphabet", "check_digit"},
+	"random_word":        {"seed", "wordlist"},
+	"typeid":             {"seed", "prefix", "timestamp"},
+	"cuid2":              {"seed", "length"},
 }
 
 func (d *TemplatedDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -98,7 +134,7 @@ func (d *TemplatedDataSource) Schema(ctx context.Context, req datasource.SchemaR
 	proquintCanonicalAttributes := map[string]schema.Attribute{
 		"seed": schema.StringAttribute{
 			Required:            true,
-			MarkdownDescription: "Seed value (IPv4 address, hex string, or integer) for canonical encoding",
+			MarkdownDescription: "Seed value (IP address, CIDR block, MAC address, UUID, hex string, or integer) for canonical encoding",
 		},
 		"group_size": schema.Int64Attribute{
 			Optional:    true,
@@ -114,7 +150,11 @@ func (d *TemplatedDataSource) Schema(ctx context.Context, req datasource.SchemaR
 		},
 		"alphabet": schema.StringAttribute{
 			Optional:            true,
-			MarkdownDescription: "Alphabet preset (`alphanumeric`, `numeric`, `readable`) or custom alphabet string. Default: `alphanumeric`",
+			MarkdownDescription: "Alphabet preset (`alphanumeric`, `numeric`, `readable`, case-insensitive) or custom alphabet string. Default: `alphanumeric`",
+		},
+		"check_digit": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: checkDigitDescription,
 		},
 	}
 	for k, v := range baseAttributes {
@@ -133,19 +173,109 @@ func (d *TemplatedDataSource) Schema(ctx context.Context, req datasource.SchemaR
 		},
 	}
 
+	// TypeID schema (prefix + timestamp + seed, no length or group_size)
+	typeidAttributes := map[string]schema.Attribute{
+		"prefix": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Type prefix (lowercase `a-z` and `_`, at most 63 characters)",
+		},
+		"seed": schema.StringAttribute{
+			Optional:    true,
+			Description: "Seed for deterministic generation of the random part",
+		},
+		"timestamp": schema.StringAttribute{
+			Optional:    true,
+			Description: "RFC 3339 timestamp embedded in the UUIDv7 (default: current time)",
+		},
+	}
+
+	// CUID2 schema (length + seed, no group_size)
+	cuid2Attributes := map[string]schema.Attribute{
+		"length": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Length of the generated CUID2 (default: 24)",
+		},
+		"seed": schema.StringAttribute{
+			Optional:    true,
+			Description: "Seed for deterministic generation",
+		},
+	}
+
+	// Components map entry schema (type + the attributes of all component types)
+	componentAttributes := map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Component type: `proquint`, `proquint_canonical`, `nanoid`, `random_word`, `typeid` or `cuid2`",
+		},
+	}
+	for _, attributes := range []map[string]schema.Attribute{proquintAttributes, nanoidAttributes, randomWordAttributes, typeidAttributes, cuid2Attributes} {
+		for k, v := range attributes {
+			if _, ok := componentAttributes[k]; !ok {
+				componentAttributes[k] = v
+			}
+		}
+	}
+	componentAttributes["seed"] = schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "Seed for deterministic generation (required for `proquint_canonical`)",
+	}
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Generates a templated identifier combining multiple ID types.\n\n" +
-			"Use Go template syntax with `.proquint`, `.proquint_canonical`, `.nanoid`, and `.random_word` variables. " +
+			"Use Go template syntax with `.proquint`, `.proquint_canonical`, `.nanoid`, `.random_word`, `.typeid`, and `.cuid2` variables, " +
+			"`.components.<name>` for the entries of `components` and `.vars.<name>` for the entries of `vars`. " +
 			"Example: `{{ .proquint }}-{{ .nanoid }}`\n\n" +
 			templateFunctionsDocs,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The generated templated ID.",
+				Description: "The generated templated ID (null if only templates is set).",
 				Computed:    true,
 			},
 			"template": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Go template string with `.proquint`, `.proquint_canonical`, `.nanoid`, and `.random_word` variables",
+				Optional: true,
+				MarkdownDescription: "Go template string for `id` with `.proquint`, `.proquint_canonical`, `.nanoid`, `.random_word`, `.typeid`, `.cuid2`, `.components.<name>`, `.vars.<name>` and, with `batch_size`, `.index` variables. " +
+					"The template is checked at plan time: referencing a variable that is not configured is an error, " +
+					"configuring a component the template does not use is a warning. At least one of `template` and `templates` must be set.",
+			},
+			"batch_size": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("Renders `template` this many times (at most %d) into `ids`, e.g. to name shards. ", MaxBatchSize) +
+					"Entry `i` (counting from 0) exposes `.index` to the template and uses the sub-seed `sha256_hex(\"<seed>/index/<i>\")` " +
+					"as its top-level `seed`, from which its components and inline generators derive their seeds. " +
+					"A component with a `seed` of its own, other than `proquint_canonical`, uses the sub-seed `sha256_hex(\"<component seed>/index/<i>\")`. " +
+					"Without `seed`, an entry that repeats the ID of an earlier entry is rendered again; " +
+					"two entries that still render the same ID are an error. `id`, `spoken`, `parts` and `outputs` are null, and `templates` cannot be set. " +
+					"(Terraform reserves `count` for the meta-argument, which would create separate data sources instead.)",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The IDs rendered by `batch_size`, in order of `.index`. Null without `batch_size`.",
+			},
+			"parts": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				MarkdownDescription: "The generated value of each configured component before templating, by template variable name " +
+					"(e.g., `parts[\"nanoid\"]` or `parts[\"components.first\"]`), so other resources can use a part on its own.",
+			},
+			"seed": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Seed for all components and inline generator functions (`nanoid`, `proquint`, `word`) without a seed of their own. " +
+					"Each derives an independent sub-seed, the lowercase hex SHA-256 of `<seed>/<key>`, where the key is the template variable name " +
+					"(e.g., `nanoid` or `components.first`) or `call/<n>` for the n-th inline call. " +
+					"Component seeds override it; `proquint_canonical` encodes its own seed and does not use it.",
+			},
+			"templates": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "Named templates rendered into `outputs`, e.g. a bucket name, a role name and a tag value. " +
+					"All templates (including `template`) render against the same component values, so related names stay consistent even when unseeded. " +
+					"`naming_profile` and `max_length` apply to `id` only; use template functions such as `dns_label` or `truncate_hash` in these templates instead.",
+			},
+			"outputs": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The rendered `templates`, by name.",
 			},
 			"proquint": schema.SingleNestedAttribute{
 				Optional:            true,
@@ -154,7 +284,7 @@ func (d *TemplatedDataSource) Schema(ctx context.Context, req datasource.SchemaR
 			},
 			"proquint_canonical": schema.SingleNestedAttribute{
 				Optional:            true,
-				MarkdownDescription: "Canonical Proquint component (encodes IPv4 addresses or integers). See [proquint_canonical](./proquint_canonical) for more details.",
+				MarkdownDescription: "Canonical Proquint component (encodes IP addresses, CIDR blocks, MAC addresses, UUIDs, hex strings or integers). See [proquint_canonical](./proquint_canonical) for more details.",
 				Attributes:          proquintCanonicalAttributes,
 			},
 			"nanoid": schema.SingleNestedAttribute{
@@ -167,6 +297,80 @@ func (d *TemplatedDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				MarkdownDescription: "Random word component configuration. See [random_word](./random_word) for more details.",
 				Attributes:          randomWordAttributes,
 			},
+			"typeid": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "TypeID component configuration. See [typeid](./typeid) for more details.",
+				Attributes:          typeidAttributes,
+			},
+			"cuid2": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "CUID2 component configuration. See [cuid2](./cuid2) for more details.",
+				Attributes:          cuid2Attributes,
+			},
+			"components": schema.MapNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Named components, available in the template as `.components.<name>`. " +
+					"Use them to combine several components of the same type, e.g. two words or NanoIDs with different alphabets. " +
+					"Each entry sets `type` and the attributes of that component type (e.g., `alphabet` only for `nanoid`). " +
+					"Names that are not valid template identifiers (e.g., containing `-`) can be used with `{{ index .components \"my-name\" }}`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: componentAttributes,
+				},
+			},
+			"vars": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "User-supplied values, available in the template as `.vars.<name>`. " +
+					"Use them instead of Terraform interpolation in `template` to apply template functions and conditionals to inputs, " +
+					"e.g. `{{ .vars.team | slug }}` or `{{ if eq .vars.stage \"prod\" }}p{{ else }}np{{ end }}`. " +
+					"Values count as template text for `naming_profile` violations.",
+			},
+			"spoken": schema.StringAttribute{
+				MarkdownDescription: "The generated ID spelled out for reading aloud, e.g. `bravo-seven-xray`. " +
+					"Uppercase letters are marked with `capital`. Use the `spoken` template function to spell only parts of the ID.",
+				Computed: true,
+			},
+			"spelling_alphabet": schema.StringAttribute{
+				MarkdownDescription: spellingAlphabetDescription,
+				Optional:            true,
+			},
+			"naming_profile": schema.StringAttribute{
+				MarkdownDescription: "Naming rules the generated ID must satisfy:\n\n" +
+					"- **`s3_bucket`** - AWS S3 bucket: 3-63 characters, lowercase letters, digits, `.` and `-`, " +
+					"starting and ending with a letter or digit, no `..`, not formatted as an IP address, no reserved prefixes (`xn--`, ...) or suffixes (`-s3alias`, ...)\n" +
+					"- **`azure_storage_account`** - Azure storage account: 3-24 characters, lowercase letters and digits only\n" +
+					"- **`gcp_project_id`** - Google Cloud project ID: 6-30 characters, lowercase letters, digits and `-`, " +
+					"starting with a letter and not ending with `-`\n" +
+					"- **`k8s_dns_label`** - Kubernetes DNS-1123 label: 1-63 characters, lowercase letters, digits and `-`, " +
+					"starting and ending with a letter or digit\n\n" +
+					"Violations are reported on the component (e.g., `nanoid`) the offending characters come from, " +
+					"or on `template` for template text and rules about the whole ID.",
+				Optional: true,
+			},
+			"naming_mode": schema.StringAttribute{
+				MarkdownDescription: "How `naming_profile` is applied:\n\n" +
+					"- **`validate`** (default) - fail if the generated ID violates the profile\n" +
+					"- **`adapt`** - rewrite the ID first: lowercase it, turn separators (`_`, space, `.`, `/`, `:`) into `-` where allowed, " +
+					"remove other illegal characters and strip leading and trailing characters the profile does not allow there. " +
+					"The adapted ID is then validated, so length violations still fail.",
+				Optional: true,
+			},
+			"max_length": schema.Int64Attribute{
+				MarkdownDescription: "Maximum length of the generated ID in characters. Longer IDs are shortened according to `truncate_strategy`. " +
+					"Truncation happens after `naming_mode = \"adapt\"` and before the `naming_profile` check.",
+				Optional: true,
+			},
+			"truncate_strategy": schema.StringAttribute{
+				MarkdownDescription: "How IDs longer than `max_length` are shortened:\n\n" +
+					"- **`hash`** (default) - Kubernetes-style: cut the ID and append `-` and a hash of the full ID, " +
+					"so distinct long IDs stay distinct (e.g., `payments-service-eu-west-1` with `max_length = 18`~>`payments-9a58e597`). " +
+					"The hash is the first 8 hex digits of the SHA-256 digest and is stable across provider versions. " +
+					"Separators at the cut are dropped, and the hash is appended without `-` if the `naming_profile` does not allow dashes " +
+					"(e.g., `azure_storage_account`); `max_length` must be at least 10\n" +
+					"- **`cut`** - cut the ID at `max_length`\n" +
+					"- **`error`** - fail if the ID is too long",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -175,6 +379,106 @@ func (d *TemplatedDataSource) Configure(ctx context.Context, req datasource.Conf
 	// Provider configuration is not needed for this simple implementation
 }
 
+// ValidateConfig checks the templates at plan time: they must parse, and the variables they
+// reference must match the configured components and vars.
+func (d *TemplatedDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data TemplatedDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Template.IsNull() && data.Templates.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("template"),
+			"Missing template",
+			"At least one of template and templates must be set.",
+		)
+		return
+	}
+
+	if !data.BatchSize.IsNull() && !data.BatchSize.IsUnknown() {
+		if batchSize := data.BatchSize.ValueInt64(); batchSize < 1 || batchSize > MaxBatchSize {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("batch_size"),
+				"Invalid batch size",
+				fmt.Sprintf("batch_size must be between 1 and %d, got %d.", MaxBatchSize, batchSize),
+			)
+		}
+		if data.Template.IsNull() || !data.Templates.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("batch_size"),
+				"Invalid batch configuration",
+				"batch_size renders template into ids: template must be set, and templates cannot be set.",
+			)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Templates not known yet are validated when they are
+	if data.Template.IsUnknown() || data.Templates.IsUnknown() {
+		return
+	}
+
+	var sources []templateSource
+	if !data.Template.IsNull() {
+		sources = append(sources, templateSource{path: path.Root("template"), text: data.Template.ValueString()})
+	}
+	if !data.Templates.IsNull() {
+		templates := make(map[string]types.String)
+		resp.Diagnostics.Append(data.Templates.ElementsAs(ctx, &templates, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		names := make([]string, 0, len(templates))
+		for name := range templates {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if templates[name].IsUnknown() {
+				return
+			}
+			sources = append(sources, templateSource{path: path.Root("templates").AtMapKey(name), text: templates[name].ValueString()})
+		}
+	}
+
+	variables := templateVariables{configured: make(map[string]bool), unknown: make(map[string]bool)}
+	components := map[string]types.Object{
+		"proquint":           data.Proquint,
+		"proquint_canonical": data.ProquintCanonical,
+		"nanoid":             data.NanoID,
+		"random_word":        data.RandomWord,
+		"typeid":             data.TypeID,
+		"cuid2":              data.CUID2,
+	}
+	for name, config := range components {
+		if !config.IsNull() {
+			variables.configured[name] = true
+		}
+	}
+	if data.BatchSize.IsUnknown() {
+		variables.unknown[templateIndexVariable] = true
+	} else if !data.BatchSize.IsNull() {
+		variables.configured[templateIndexVariable] = true
+	}
+	for prefix, values := range map[string]types.Map{"components": data.Components, "vars": data.Vars} {
+		if values.IsUnknown() {
+			variables.unknown[prefix] = true
+			continue
+		}
+		for name := range values.Elements() {
+			variables.configured[prefix+"."+name] = true
+		}
+	}
+
+	validateTemplateReferences(sources, variables, &resp.Diagnostics)
+}
+
 func (d *TemplatedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data TemplatedDataSourceModel
 
@@ -185,206 +489,1009 @@ func (d *TemplatedDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	// Generate ID components
+	vars := make(map[string]string)
+	if !data.Vars.IsNull() {
+		resp.Diagnostics.Append(data.Vars.ElementsAs(ctx, &vars, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.IDs = types.ListNull(types.StringType)
+	if !data.BatchSize.IsNull() {
+		ids := renderBatch(ctx, data, vars, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.IDs, diags = types.ListValueFrom(ctx, types.StringType, ids)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.ID = types.StringNull()
+		data.Spoken = types.StringNull()
+		data.Parts = types.MapNull(types.StringType)
+		data.Outputs = types.MapNull(types.StringType)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	idComponents := generateComponents(ctx, data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Parts, diags = types.MapValueFrom(ctx, types.StringType, idComponents)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All templates render against the same component values and inline generator calls
+	values := templateData(idComponents, vars)
+	generators := newInlineGenerators(data.Seed)
+
+	data.ID = types.StringNull()
+	data.Spoken = types.StringNull()
+	if !data.Template.IsNull() {
+		templateStr := data.Template.ValueString()
+		rendered, ok := renderTemplate(path.Root("template"), templateStr, values, generators, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+
+		id := finalizeTemplatedID(rendered, templateStr, idComponents, vars, nil, data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.ID = types.StringValue(id)
+		data.Spoken = spellID(id, data.SpellingAlphabet, true, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.Outputs = types.MapNull(types.StringType)
+	if !data.Templates.IsNull() {
+		templates := make(map[string]string)
+		resp.Diagnostics.Append(data.Templates.ElementsAs(ctx, &templates, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		names := make([]string, 0, len(templates))
+		for name := range templates {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		outputs := make(map[string]string, len(templates))
+		for _, name := range names {
+			rendered, ok := renderTemplate(path.Root("templates").AtMapKey(name), templates[name], values, generators, &resp.Diagnostics)
+			if ok {
+				outputs[name] = rendered
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Outputs, diags = types.MapValueFrom(ctx, types.StringType, outputs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// generateComponents generates the configured components by template variable name,
+// with sub-seeds derived from the top-level seed for components without a seed.
+func generateComponents(ctx context.Context, data TemplatedDataSourceModel, index *int, diags *diag.Diagnostics) map[string]string {
 	idComponents := make(map[string]string)
 
 	// Generate proquint if configured
 	if !data.Proquint.IsNull() {
 		var config ProquintConfig
-		resp.Diagnostics.Append(data.Proquint.As(ctx, &config, basetypes.ObjectAsOptions{})...)
-		if !resp.Diagnostics.HasError() {
-			id := generateProquint(config)
-			idComponents["proquint"] = id
+		diags.Append(data.Proquint.As(ctx, &config, basetypes.ObjectAsOptions{})...)
+		if !diags.HasError() {
+			config.Seed = componentSeed(config.Seed, data.Seed, "proquint", index)
+			if id, ok := generateComponent(path.Root("proquint"), diags, func(diags *diag.Diagnostics) string {
+				return generateProquint(config, false, diags)
+			}); ok {
+				idComponents["proquint"] = id
+			}
 		}
 	}
 
 	// Generate proquint_canonical if configured
 	if !data.ProquintCanonical.IsNull() {
 		var config ProquintCanonicalConfig
-		resp.Diagnostics.Append(data.ProquintCanonical.As(ctx, &config, basetypes.ObjectAsOptions{})...)
-		if !resp.Diagnostics.HasError() {
-			id := generateProquintCanonical(config, &resp.Diagnostics)
-			idComponents["proquint_canonical"] = id
+		diags.Append(data.ProquintCanonical.As(ctx, &config, basetypes.ObjectAsOptions{})...)
+		if !diags.HasError() {
+			if id, ok := generateComponent(path.Root("proquint_canonical"), diags, func(diags *diag.Diagnostics) string {
+				return generateProquintCanonical(config, diags)
+			}); ok {
+				idComponents["proquint_canonical"] = id
+			}
 		}
 	}
 
 	// Generate nanoid if configured
 	if !data.NanoID.IsNull() {
 		var config NanoIDConfig
-		resp.Diagnostics.Append(data.NanoID.As(ctx, &config, basetypes.ObjectAsOptions{})...)
-		if !resp.Diagnostics.HasError() {
-			id, err := generateNanoID(config, &resp.Diagnostics)
-			if err != nil {
-				resp.Diagnostics.AddError("Failed to generate NanoID", err.Error())
-				return
+		diags.Append(data.NanoID.As(ctx, &config, basetypes.ObjectAsOptions{})...)
+		if !diags.HasError() {
+			config.Seed = componentSeed(config.Seed, data.Seed, "nanoid", index)
+			if id, ok := generateComponent(path.Root("nanoid"), diags, func(diags *diag.Diagnostics) string {
+				return generateNanoID(templatedNanoIDConfig(config), diags)
+			}); ok {
+				idComponents["nanoid"] = id
 			}
-			idComponents["nanoid"] = id
 		}
 	}
 
 	// Generate random_word if configured
 	if !data.RandomWord.IsNull() {
 		var config RandomWordConfig
-		resp.Diagnostics.Append(data.RandomWord.As(ctx, &config, basetypes.ObjectAsOptions{})...)
-		if !resp.Diagnostics.HasError() {
-			id := generateRandomWord(config)
-			idComponents["random_word"] = id
+		diags.Append(data.RandomWord.As(ctx, &config, basetypes.ObjectAsOptions{})...)
+		if !diags.HasError() {
+			config.Seed = componentSeed(config.Seed, data.Seed, "random_word", index)
+			idComponents["random_word"] = generateRandomWord(config)
 		}
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	// Generate typeid if configured
+	if !data.TypeID.IsNull() {
+		var config TypeIDConfig
+		diags.Append(data.TypeID.As(ctx, &config, basetypes.ObjectAsOptions{})...)
+		if !diags.HasError() {
+			config.Seed = componentSeed(config.Seed, data.Seed, "typeid", index)
+			if id, ok := generateComponent(path.Root("typeid"), diags, func(diags *diag.Diagnostics) string {
+				return generateTypeID(config, diags)
+			}); ok {
+				idComponents["typeid"] = id
+			}
+		}
+	}
+
+	// Generate cuid2 if configured
+	if !data.CUID2.IsNull() {
+		var config CUID2Config
+		diags.Append(data.CUID2.As(ctx, &config, basetypes.ObjectAsOptions{})...)
+		if !diags.HasError() {
+			config.Seed = componentSeed(config.Seed, data.Seed, "cuid2", index)
+			if id, ok := generateComponent(path.Root("cuid2"), diags, func(diags *diag.Diagnostics) string {
+				return generateCUID2(config, diags)
+			}); ok {
+				idComponents["cuid2"] = id
+			}
+		}
+	}
+
+	// Generate named components if configured
+	if !data.Components.IsNull() {
+		components := make(map[string]TemplateComponentConfig)
+		diags.Append(data.Components.ElementsAs(ctx, &components, false)...)
+		if diags.HasError() {
+			return nil
+		}
+
+		names := make([]string, 0, len(components))
+		for name := range components {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			config := components[name]
+			if config.Type.ValueString() != "proquint_canonical" {
+				config.Seed = componentSeed(config.Seed, data.Seed, componentsPrefix+name, index)
+			}
+			id, ok := generateTemplateComponent(name, config, diags)
+			if !ok {
+				continue
+			}
+			idComponents[componentsPrefix+name] = id
+		}
+	}
+
+	if diags.HasError() {
+		return nil
 	}
 
-	// Apply template with custom functions
-	templateStr := data.Template.ValueString()
-	tmpl, err := template.New("id").Funcs(templateFuncs()).Parse(templateStr)
+	return idComponents
+}
+
+// renderBatch renders the template batch_size times. Entry i sees .index = i, and its components
+// and inline generators derive their seeds from the entry seed batchEntrySeed(seed, i).
+// An unseeded entry that repeats an earlier ID is rendered again, up to MaxBatchEntryAttempts
+// times. It reports an error if two entries still render the same ID.
+func renderBatch(ctx context.Context, data TemplatedDataSourceModel, vars map[string]string, diags *diag.Diagnostics) []string {
+	batchSize := int(data.BatchSize.ValueInt64())
+
+	// Seeded entries are reproducible, so rendering them again would repeat the duplicate
+	attempts := 1
+	if data.Seed.IsNull() {
+		attempts = MaxBatchEntryAttempts
+	}
+
+	ids := make([]string, 0, batchSize)
+	rendered := make(map[string]int, batchSize)
+	for index := 0; index < batchSize; index++ {
+		entry := data
+		if !data.Seed.IsNull() {
+			entry.Seed = types.StringValue(batchEntrySeed(data.Seed.ValueString(), index))
+		}
+
+		var id string
+		var previous int
+		duplicate := true
+		for attempt := 0; attempt < attempts && duplicate; attempt++ {
+			var ok bool
+			if id, ok = renderBatchEntry(ctx, entry, vars, index, diags); !ok {
+				return nil
+			}
+			previous, duplicate = rendered[id]
+		}
+
+		if duplicate {
+			diags.AddAttributeError(
+				path.Root("batch_size"),
+				"Duplicate ID in batch",
+				fmt.Sprintf("Entries %d and %d both render %q. Reference .index in the template, "+
+					"or use components with more possible values.", previous, index, id),
+			)
+			return nil
+		}
+		rendered[id] = index
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// renderBatchEntry generates the components of the batch entry with the given index and
+// renders the template for it. It returns false if an error was reported.
+func renderBatchEntry(ctx context.Context, entry TemplatedDataSourceModel, vars map[string]string, index int, diags *diag.Diagnostics) (string, bool) {
+	templateStr := entry.Template.ValueString()
+
+	components := generateComponents(ctx, entry, &index, diags)
+	if diags.HasError() {
+		return "", false
+	}
+
+	values := templateData(components, vars)
+	values[templateIndexVariable] = index
+	id, ok := renderTemplate(path.Root("template"), templateStr, values, newInlineGenerators(entry.Seed), diags)
+	if !ok {
+		return "", false
+	}
+
+	id = finalizeTemplatedID(id, templateStr, components, vars, &index, entry, diags)
+	return id, !diags.HasError()
+}
+
+// batchEntrySeed returns the sub-seed idgen.DeriveSeed(seed, "index/<index>") that the batch
+// entry with the given index uses in place of seed.
+func batchEntrySeed(seed string, index int) string {
+	return idgen.DeriveSeed(seed, fmt.Sprintf("index/%d", index))
+}
+
+// renderTemplate parses and executes the template configured at attrPath against the
+// template data, with the given inline generators. It reports errors at attrPath
+// and returns false on failure.
+func renderTemplate(attrPath path.Path, templateStr string, values map[string]any, generators *inlineGenerators, diags *diag.Diagnostics) (string, bool) {
+	generators.restart()
+	tmpl, err := template.New("id").Funcs(templateFuncs()).Funcs(generators.funcs()).Option("missingkey=error").Parse(templateStr)
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid template", err.Error())
-		return
+		diags.AddAttributeError(attrPath, "Invalid template", err.Error())
+		return "", false
 	}
 
 	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, idComponents); err != nil {
-		resp.Diagnostics.AddError("Failed to execute template", err.Error())
+	if err := tmpl.Execute(&buf, values); err != nil {
+		diags.AddAttributeError(attrPath, "Failed to execute template", err.Error())
+		return "", false
+	}
+
+	return buf.String(), true
+}
+
+// componentSeed returns the seed of the component with the given template variable name:
+// its own seed if set, otherwise the sub-seed idgen.DeriveSeed(seed, name) of the top-level
+// seed, so that components configured with one seed do not share a random stream. In the
+// batch entry with a non-nil index, its own seed s is replaced by batchEntrySeed(s, index).
+func componentSeed(componentSeed, seed types.String, name string, index *int) types.String {
+	if !componentSeed.IsNull() && index != nil {
+		return types.StringValue(batchEntrySeed(componentSeed.ValueString(), *index))
+	}
+	if !componentSeed.IsNull() || seed.IsNull() {
+		return componentSeed
+	}
+	return types.StringValue(idgen.DeriveSeed(seed.ValueString(), name))
+}
+
+// generateTemplateComponent generates the value of the components map entry name.
+// It reports an error and returns false if the type is unknown, an attribute is not
+// supported by the type, or generation fails.
+func generateTemplateComponent(name string, config TemplateComponentConfig, diags *diag.Diagnostics) (string, bool) {
+	attrPath := path.Root("components").AtMapKey(name)
+	componentType := config.Type.ValueString()
+
+	supported, ok := templateComponentAttributes[componentType]
+	if !ok {
+		diags.AddAttributeError(
+			attrPath.AtName("type"),
+			"Invalid component type",
+			fmt.Sprintf("Component type '%s' is not supported. Valid types are: proquint, proquint_canonical, nanoid, random_word, typeid, cuid2.", componentType),
+		)
+		return "", false
+	}
+
+	set := map[string]bool{
+		"seed":        !config.Seed.IsNull(),
+		"length":      !config.Length.IsNull(),
+		"group_size":  !config.GroupSize.IsNull(),
+		"alphabet":    !config.Alphabet.IsNull(),
+		"check_digit": !config.CheckDigit.IsNull(),
+		"wordlist":    !config.Wordlist.IsNull(),
+		"prefix":      !config.Prefix.IsNull(),
+		"timestamp":   !config.Timestamp.IsNull(),
+	}
+	for _, attribute := range supported {
+		delete(set, attribute)
+	}
+	unsupported := false
+	for _, attribute := range []string{"seed", "length", "group_size", "alphabet", "check_digit", "wordlist", "prefix", "timestamp"} {
+		if set[attribute] {
+			diags.AddAttributeError(
+				attrPath.AtName(attribute),
+				"Unsupported component attribute",
+				fmt.Sprintf("Attribute '%s' is not supported by %s components. Supported attributes are: %s.", attribute, componentType, strings.Join(supported, ", ")),
+			)
+			unsupported = true
+		}
+	}
+	if unsupported {
+		return "", false
+	}
+
+	return generateComponent(attrPath, diags, func(diags *diag.Diagnostics) string {
+		switch componentType {
+		case "proquint":
+			return generateProquint(ProquintConfig{Length: config.Length, Seed: config.Seed, GroupSize: config.GroupSize}, false, diags)
+		case "proquint_canonical":
+			return generateProquintCanonical(ProquintCanonicalConfig{Seed: config.Seed, GroupSize: config.GroupSize}, diags)
+		case "nanoid":
+			return generateNanoID(templatedNanoIDConfig(NanoIDConfig{
+				Length:     config.Length,
+				Seed:       config.Seed,
+				GroupSize:  config.GroupSize,
+				Alphabet:   config.Alphabet,
+				CheckDigit: config.CheckDigit,
+			}), diags)
+		case "random_word":
+			return generateRandomWord(RandomWordConfig{Seed: config.Seed, Wordlist: config.Wordlist})
+		case "typeid":
+			return generateTypeID(TypeIDConfig{Prefix: config.Prefix, Seed: config.Seed, Timestamp: config.Timestamp}, diags)
+		default:
+			return generateCUID2(CUID2Config{Length: config.Length, Seed: config.Seed}, diags)
+		}
+	})
+}
+
+// templatePlaceholder matches the placeholders renderTemplatePlaceholders renders for each variable.
+var templatePlaceholder = regexp.MustCompile("\x00([^\x00]*)\x00")
+
+// templateComponentNames lists the variables available in templates.
+var templateComponentNames = []string{"proquint", "proquint_canonical", "nanoid", "random_word", "typeid", "cuid2"}
+
+// componentsPrefix prefixes the names of components map entries among the template variables,
+// matching how they are accessed in templates (.components.<name>).
+const componentsPrefix = "components."
+
+// templateData converts generated values keyed by variable name into template data,
+// nesting the components map entries ("components.<name>") under "components".
+// User-supplied vars are added as "vars", if any.
+func templateData(values map[string]string, vars map[string]string) map[string]any {
+	data := make(map[string]any, len(values)+1)
+	if len(vars) > 0 {
+		data["vars"] = vars
+	}
+	var components map[string]string
+	for name, value := range values {
+		if entry, ok := strings.CutPrefix(name, componentsPrefix); ok {
+			if components == nil {
+				components = make(map[string]string)
+				data["components"] = components
+			}
+			components[entry] = value
+			continue
+		}
+		data[name] = value
+	}
+	return data
+}
+
+// templateIndexVariable is the position of an entry in a batch, available in the template as .index.
+const templateIndexVariable = "index"
+
+// templateVariables describes the variables a configuration provides to its template.
+type templateVariables struct {
+	// configured holds the configured variables, e.g. "nanoid", "components.first" or "vars.stage".
+	configured map[string]bool
+
+	// unknown holds "components" or "vars" if their entries are not known yet (e.g., at plan time).
+	unknown map[string]bool
+}
+
+// templateSource is a template and the attribute path it is configured at.
+type templateSource struct {
+	path path.Path
+	text string
+}
+
+// validateTemplateReferences parses the templates and reports an error for each referenced
+// variable that is not configured, and a warning for each configured component none of the
+// templates use.
+func validateTemplateReferences(sources []templateSource, variables templateVariables, diags *diag.Diagnostics) {
+	referenced := make(map[string]bool)
+	for _, source := range sources {
+		tmpl, err := template.New("id").Funcs(templateFuncs()).Option("missingkey=error").Parse(source.text)
+		if err != nil {
+			diags.AddAttributeError(source.path, "Invalid template", err.Error())
+			return
+		}
+
+		for _, ref := range templateReferences(tmpl) {
+			referenced[ref] = true
+			validateTemplateReference(source.path, ref, variables, diags)
+		}
+	}
+
+	names := make([]string, 0, len(variables.configured))
+	for name := range variables.configured {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		// Unused vars are fine, e.g. values shared between several data sources,
+		// and batches need not reference .index if their components differ anyway
+		if referenced[name] || strings.HasPrefix(name, "vars.") || name == templateIndexVariable {
+			continue
+		}
+		if strings.HasPrefix(name, componentsPrefix) && referenced["components"] {
+			continue
+		}
+		diags.AddAttributeWarning(templateVariablePath(name), "Unused template component",
+			fmt.Sprintf("The %s component is configured, but no template uses .%s.", name, name))
+	}
+}
+
+// validateTemplateReference reports an error at attrPath if the variable ref, referenced by the
+// template configured there, is not configured.
+func validateTemplateReference(attrPath path.Path, ref string, variables templateVariables, diags *diag.Diagnostics) {
+	prefix, entry, _ := strings.Cut(ref, ".")
+	if variables.unknown[prefix] {
 		return
 	}
 
-	data.ID = types.StringValue(buf.String())
+	switch {
+	case prefix == "components" || prefix == "vars":
+		if entry == "" {
+			if !hasConfiguredEntries(variables, prefix) {
+				diags.AddAttributeError(attrPath, "Undefined template variable",
+					fmt.Sprintf("The template references .%s, but %s is not set.", prefix, prefix))
+			}
+			return
+		}
+		if !variables.configured[ref] {
+			diags.AddAttributeError(attrPath, "Undefined template variable",
+				fmt.Sprintf("The template references .%s, but %s has no entry %q.", ref, prefix, entry))
+		}
+	case ref == templateIndexVariable:
+		if !variables.configured[ref] {
+			diags.AddAttributeError(attrPath, "Undefined template variable",
+				"The template references .index, but batch_size is not set.")
+		}
+	case isTemplateComponentName(prefix):
+		if !variables.configured[ref] {
+			diags.AddAttributeError(attrPath, "Undefined template variable",
+				fmt.Sprintf("The template references .%s, but no %s component is configured. Add a %s block to configure it.", ref, ref, ref))
+		}
+	default:
+		diags.AddAttributeError(attrPath, "Undefined template variable",
+			fmt.Sprintf("The template references .%s, which is not a template variable. Valid variables are: .%s, .components.<name> and .vars.<name>.",
+				ref, strings.Join(templateComponentNames, ", .")))
+	}
+}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+// hasConfiguredEntries returns whether the components or vars map (prefix) has configured entries.
+func hasConfiguredEntries(variables templateVariables, prefix string) bool {
+	for name := range variables.configured {
+		if strings.HasPrefix(name, prefix+".") {
+			return true
+		}
+	}
+	return false
 }
 
-// Helper functions to generate IDs
-func generateProquint(config ProquintConfig) string {
-	length := 11
-	if !config.Length.IsNull() {
-		length = int(config.Length.ValueInt64())
+// isTemplateComponentName returns whether name is one of templateComponentNames.
+func isTemplateComponentName(name string) bool {
+	for _, component := range templateComponentNames {
+		if component == name {
+			return true
+		}
 	}
+	return false
+}
 
-	var seed *int64
-	if !config.Seed.IsNull() {
-		seedVal, _ := idgen.StringToSeed(config.Seed.ValueString())
-		seed = &seedVal
+// templateReferences returns the variables referenced by a parsed template, in order of
+// first appearance: top-level names (e.g., "nanoid"), entries of components and vars
+// (e.g., "components.first", also via index with a constant key), or "components" and
+// "vars" if they are used as a whole. Fields inside range and with blocks are relative
+// to a different dot and skipped, unless accessed via $.
+func templateReferences(tmpl *template.Template) []string {
+	var references []string
+	seen := make(map[string]bool)
+	add := func(ident ...string) {
+		name := ident[0]
+		if (name == "components" || name == "vars") && len(ident) > 1 {
+			name += "." + ident[1]
+		}
+		if !seen[name] {
+			seen[name] = true
+			references = append(references, name)
+		}
 	}
 
-	byteLength := (length + 1) / 6 * 2
-	if byteLength < 2 {
-		byteLength = 2
+	var walk func(node parse.Node, rootDot bool)
+	walk = func(node parse.Node, rootDot bool) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child, rootDot)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe, rootDot)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, cmd := range n.Cmds {
+				walk(cmd, rootDot)
+			}
+		case *parse.CommandNode:
+			// index .components "name" references a single entry
+			if len(n.Args) == 3 && rootDot {
+				fn, isIdent := n.Args[0].(*parse.IdentifierNode)
+				field, isField := n.Args[1].(*parse.FieldNode)
+				key, isString := n.Args[2].(*parse.StringNode)
+				if isIdent && fn.Ident == "index" && isField && isString && len(field.Ident) == 1 &&
+					(field.Ident[0] == "components" || field.Ident[0] == "vars") {
+					add(field.Ident[0], key.Text)
+					return
+				}
+			}
+			for _, arg := range n.Args {
+				walk(arg, rootDot)
+			}
+		case *parse.FieldNode:
+			if rootDot {
+				add(n.Ident...)
+			}
+		case *parse.VariableNode:
+			if len(n.Ident) > 1 && n.Ident[0] == "$" {
+				add(n.Ident[1:]...)
+			}
+		case *parse.ChainNode:
+			walk(n.Node, rootDot)
+		case *parse.IfNode:
+			walk(n.Pipe, rootDot)
+			walk(n.List, rootDot)
+			walk(n.ElseList, rootDot)
+		case *parse.RangeNode:
+			walk(n.Pipe, rootDot)
+			walk(n.List, false)
+			walk(n.ElseList, rootDot)
+		case *parse.WithNode:
+			walk(n.Pipe, rootDot)
+			walk(n.List, false)
+			walk(n.ElseList, rootDot)
+		case *parse.TemplateNode:
+			walk(n.Pipe, rootDot)
+		}
 	}
 
-	id, _ := idgen.GenerateProquint(byteLength, seed, false)
+	if tmpl.Tree != nil {
+		walk(tmpl.Tree.Root, true)
+	}
 
-	// Determine group size (default to 5 for standard proquint format)
-	groupSize := 5
-	if !config.GroupSize.IsNull() {
-		groupSize = int(config.GroupSize.ValueInt64())
+	// Templates defined with {{ define }} are assumed to be invoked with the root dot
+	var defined []*template.Template
+	for _, t := range tmpl.Templates() {
+		if t.Name() != tmpl.Name() && t.Tree != nil {
+			defined = append(defined, t)
+		}
+	}
+	sort.Slice(defined, func(i, j int) bool { return defined[i].Name() < defined[j].Name() })
+	for _, t := range defined {
+		walk(t.Tree.Root, true)
 	}
 
-	// Remove all dashes and apply grouping
-	if groupSize > 0 {
-		id = strings.ReplaceAll(id, "-", "")
-		id = idgen.ApplyGrouping(id, groupSize)
+	return references
+}
+
+// renderTemplatePlaceholders executes a template with a placeholder ("\x00name\x00") for each
+// of the given variables, revealing where the variables end up in the output. Template
+// functions that transform a variable transform its placeholder too (e.g., upper~>"\x00NANOID\x00");
+// functions that drop the placeholder markers (e.g., slug) are reported as an error.
+// User-supplied vars, the batch index (if not nil) and seeded inline generator calls are
+// rendered with their values, like template text.
+func renderTemplatePlaceholders(templateStr string, names []string, vars map[string]string, index *int, seed types.String) (string, error) {
+	tmpl, err := template.New("id").Funcs(templateFuncs()).Option("missingkey=error").Parse(templateStr)
+	if err != nil {
+		return "", err
 	}
 
-	return id
+	placeholders := make(map[string]string, len(names))
+	empty := make(map[string]string, len(names))
+	for _, name := range names {
+		placeholders[name] = "\x00" + name + "\x00"
+		empty[name] = ""
+	}
+
+	values := func(components map[string]string) map[string]any {
+		data := templateData(components, vars)
+		if index != nil {
+			data[templateIndexVariable] = *index
+		}
+		return data
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Funcs(newInlineGenerators(seed).funcs()).Execute(&buf, values(placeholders)); err != nil {
+		return "", err
+	}
+
+	// Without the placeholders, only the template text must remain
+	var text bytes.Buffer
+	if err := tmpl.Funcs(newInlineGenerators(seed).funcs()).Execute(&text, values(empty)); err != nil || templatePlaceholder.ReplaceAllString(buf.String(), "") != text.String() {
+		return "", fmt.Errorf("a template function transforms a variable; " +
+			"only variables used as they are (optionally with text added around them) can be located")
+	}
+
+	return buf.String(), nil
 }
 
-func generateNanoID(config NanoIDConfig, diags *diag.Diagnostics) (string, error) {
-	length := 21
-	if !config.Length.IsNull() {
-		length = int(config.Length.ValueInt64())
+// templateComponentSpan is the byte range a template variable occupies in a rendered ID.
+type templateComponentSpan struct {
+	name       string
+	start, end int
+}
+
+// templateComponentSpans locates the variables of templateStr in the rendered ID, rendered
+// with the batch index if not nil. It returns nil if the positions cannot be determined,
+// e.g. because a template function transforms a variable.
+func templateComponentSpans(templateStr string, components, vars map[string]string, index *int, seed types.String, rendered string) []templateComponentSpan {
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
 	}
 
-	alphabet := idgen.Alphanumeric
-	if !config.Alphabet.IsNull() {
-		alphabetStr := config.Alphabet.ValueString()
-		switch strings.ToLower(alphabetStr) {
-		case "alphanumeric":
-			alphabet = idgen.Alphanumeric
-		case "numeric":
-			alphabet = idgen.Numeric
-		case "readable":
-			alphabet = idgen.Readable
-		default:
-			alphabet = alphabetStr
+	placeholders, err := renderTemplatePlaceholders(templateStr, names, vars, index, seed)
+	if err != nil {
+		return nil
+	}
+
+	var spans []templateComponentSpan
+	var rebuilt strings.Builder
+	last := 0
+	for _, m := range templatePlaceholder.FindAllStringSubmatchIndex(placeholders, -1) {
+		name := placeholders[m[2]:m[3]]
+		value, ok := components[name]
+		if !ok {
+			return nil
 		}
+		rebuilt.WriteString(placeholders[last:m[0]])
+		spans = append(spans, templateComponentSpan{name: name, start: rebuilt.Len(), end: rebuilt.Len() + len(value)})
+		rebuilt.WriteString(value)
+		last = m[1]
 	}
+	rebuilt.WriteString(placeholders[last:])
 
-	// Warn if alphabet contains dashes and grouping is enabled
-	if !config.GroupSize.IsNull() && config.GroupSize.ValueInt64() > 0 {
-		if strings.Contains(alphabet, "-") {
-			diags.AddWarning(
-				warningAlphabetContainsDashTitle,
-				warningAlphabetContainsDashDetail,
+	// Functions applied to the whole output or cutting placeholders make the positions unreliable
+	if rebuilt.String() != rendered {
+		return nil
+	}
+	return spans
+}
+
+// finalizeTemplatedID applies naming_mode = "adapt", max_length and naming_profile validation
+// to the rendered template, in this order, so that truncated IDs are still checked against
+// the profile. index is the position of the ID in a batch, or nil outside of batches.
+func finalizeTemplatedID(rendered, templateStr string, components, vars map[string]string, index *int, data TemplatedDataSourceModel, diags *diag.Diagnostics) string {
+	id := rendered
+
+	var profile idgen.NamingProfile
+	adapted := false
+	if !data.NamingProfile.IsNull() {
+		var err error
+		profile, err = idgen.GetNamingProfile(data.NamingProfile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("naming_profile"), "Invalid naming profile", err.Error())
+			return id
+		}
+
+		switch mode := data.NamingMode.ValueString(); mode {
+		case "", namingModeValidate:
+		case namingModeAdapt:
+			id = profile.Adapt(id)
+			adapted = true
+		default:
+			diags.AddAttributeError(
+				path.Root("naming_mode"),
+				"Invalid naming mode",
+				fmt.Sprintf("Naming mode '%s' is not supported. Valid modes are: validate, adapt.", mode),
 			)
+			return id
 		}
 	}
 
-	var seed *int64
-	if !config.Seed.IsNull() {
-		seedVal, _ := idgen.StringToSeed(config.Seed.ValueString())
-		seed = &seedVal
+	if !data.MaxLength.IsNull() {
+		// The hash is joined with a separator the naming profile allows
+		separator := "-"
+		if !data.NamingProfile.IsNull() {
+			separator = profile.Separator()
+		}
+		id = truncateID(id, data.MaxLength.ValueInt64(), data.TruncateStrategy.ValueString(), separator, diags)
+		if diags.HasError() {
+			return id
+		}
 	}
 
-	// Determine group size for length calculation
-	groupSize := 0
-	if !config.GroupSize.IsNull() {
-		groupSize = int(config.GroupSize.ValueInt64())
+	if !data.NamingProfile.IsNull() {
+		// Positions in an adapted or truncated ID no longer match the components
+		var spans []templateComponentSpan
+		if id == rendered {
+			spans = templateComponentSpans(templateStr, components, vars, index, data.Seed, id)
+		}
+		reportNamingViolations(id, data.NamingProfile.ValueString(), profile, spans, components, adapted, diags)
 	}
 
-	// Generate the NanoID (grouping is applied internally if groupSize > 0)
-	return idgen.GenerateNanoID(alphabet, length, seed, groupSize)
+	return id
 }
 
-func generateProquintCanonical(config ProquintCanonicalConfig, diags *diag.Diagnostics) string {
-	if config.Seed.IsNull() {
-		diags.AddError("Seed required", "proquint_canonical requires a seed value")
-		return ""
+// truncateID shortens an ID exceeding maxLength characters according to the truncate strategy.
+// The hash strategy joins the cut ID and the hash with separator.
+func truncateID(id string, maxLength int64, strategy, separator string, diags *diag.Diagnostics) string {
+	if maxLength < 1 {
+		diags.AddAttributeError(path.Root("max_length"), "Invalid max_length", fmt.Sprintf("max_length must be at least 1, got %d.", maxLength))
+		return id
 	}
 
-	value, _, errMsg := stringToCanonicalValue(config.Seed.ValueString())
-	if errMsg != "" {
-		diags.AddError("Invalid seed for canonical proquint", errMsg)
-		return ""
+	switch strategy {
+	case "", truncateStrategyHash:
+		truncated, err := idgen.TruncateWithHashSeparator(id, int(maxLength), separator)
+		if err != nil {
+			diags.AddAttributeError(path.Root("max_length"), "Invalid max_length", err.Error()+".")
+			return id
+		}
+		return truncated
+	case truncateStrategyCut:
+		return idgen.Truncate(id, int(maxLength))
+	case truncateStrategyError:
+		if length := len([]rune(id)); length > int(maxLength) {
+			diags.AddAttributeError(
+				path.Root("max_length"),
+				"ID exceeds maximum length",
+				fmt.Sprintf("The generated ID '%s' is %d characters long, the maximum is %d.", id, length, maxLength),
+			)
+		}
+		return id
+	default:
+		diags.AddAttributeError(
+			path.Root("truncate_strategy"),
+			"Invalid truncate strategy",
+			fmt.Sprintf("Truncate strategy '%s' is not supported. Valid strategies are: hash, cut, error.", strategy),
+		)
+		return id
 	}
+}
 
-	id, _ := idgen.GenerateCanonicalProquint(value)
+// reportNamingViolations adds an error diagnostic for each attribute that violations of the
+// naming profile are attributed to: the component the offending characters come from
+// (located via spans), or the template for template text and rules about the whole ID.
+func reportNamingViolations(id, profileName string, profile idgen.NamingProfile, spans []templateComponentSpan, components map[string]string, adapted bool, diags *diag.Diagnostics) {
+	// Group the violations by the attribute they are reported on
+	var targets []string
+	messages := make(map[string][]string)
+	inTemplateText := false
+	for _, v := range profile.Validate(id) {
+		target := "template"
+		for _, span := range spans {
+			if v.Position >= span.start && v.Position < span.end {
+				target = span.name
+				break
+			}
+		}
+		if target == "template" && v.Position >= 0 && spans != nil {
+			inTemplateText = true
+		}
+		if _, ok := messages[target]; !ok {
+			targets = append(targets, target)
+		}
+		messages[target] = append(messages[target], v.Message)
+	}
+
+	for _, target := range targets {
+		detail := fmt.Sprintf("The generated ID '%s' does not satisfy the %s naming profile:\n\n- %s",
+			id, profileName, strings.Join(messages[target], "\n- "))
+		if target != "template" {
+			detail += fmt.Sprintf("\n\nThe offending characters come from the %s component ('%s').", target, components[target])
+		} else if inTemplateText {
+			detail += "\n\nThe offending characters are part of the template text."
+		}
+		if !adapted {
+			detail += "\n\nSet naming_mode = \"adapt\" to rewrite the ID automatically."
+		}
+		diags.AddAttributeError(templateVariablePath(target), "ID violates naming profile", detail)
+	}
+}
 
-	// Determine group size (default to 5 for standard proquint format)
-	groupSize := 5
-	if !config.GroupSize.IsNull() {
-		groupSize = int(config.GroupSize.ValueInt64())
+// templateVariablePath returns the attribute path configuring the template variable name.
+func templateVariablePath(name string) path.Path {
+	if entry, ok := strings.CutPrefix(name, componentsPrefix); ok {
+		return path.Root("components").AtMapKey(entry)
 	}
+	return path.Root(name)
+}
 
-	// Remove all dashes and apply grouping
-	if groupSize > 0 {
-		id = strings.ReplaceAll(id, "-", "")
-		id = idgen.ApplyGrouping(id, groupSize)
+// hashFunc returns a template function hashing its piped value with algorithm,
+// encoded as hex or as the encoding given as optional first argument.
+func hashFunc(algorithm string) func(args ...string) (string, error) {
+	return func(args ...string) (string, error) {
+		if len(args) == 0 || len(args) > 2 {
+			return "", fmt.Errorf("%s expects a value and an optional encoding, got %d arguments", algorithm, len(args))
+		}
+		encoding := idgen.HashEncodingHex
+		if len(args) == 2 {
+			encoding = args[0]
+		}
+		digest, err := idgen.HashDigest(algorithm, []byte(args[len(args)-1]))
+		if err != nil {
+			return "", err
+		}
+		return idgen.EncodeDigest(digest, encoding)
 	}
+}
 
-	return id
+// inlineGenerators provides the generator functions that can be called inside templates
+// (e.g., {{ nanoid 6 "readable" }}). If seeded, the n-th call (counting from 1, in order of
+// execution) is seeded with idgen.DeriveSeed(seed, "call/<n>").
+//
+// Templates rendered with the same generators share their values: the n-th call yields the
+// same value in each template if it calls the same function with the same arguments, so
+// unseeded calls stay consistent between template and templates like seeded ones.
+type inlineGenerators struct {
+	seed   types.String
+	calls  int
+	values map[string]string
 }
 
-func generateRandomWord(config RandomWordConfig) string {
-	seed := ""
-	if !config.Seed.IsNull() {
-		seed = config.Seed.ValueString()
+// newInlineGenerators returns inline generators seeded by seed, or random if seed is null.
+func newInlineGenerators(seed types.String) *inlineGenerators {
+	return &inlineGenerators{seed: seed, values: make(map[string]string)}
+}
+
+// restart starts counting call positions from 1 again for the next template execution.
+func (g *inlineGenerators) restart() {
+	g.calls = 0
+}
+
+// call returns the value of the next call, described by key (the function and its arguments).
+// It generates the value with the call's sub-seed ("" if unseeded) unless an earlier template
+// already made the same call at this position.
+func (g *inlineGenerators) call(key string, generate func(seed string) (string, error)) (string, error) {
+	g.calls++
+	key = fmt.Sprintf("%d/%s", g.calls, key)
+	if value, ok := g.values[key]; ok {
+		return value, nil
 	}
 
-	var wordlist []string
-	if !config.Wordlist.IsNull() {
-		wordlist = parseWordlist(config.Wordlist.ValueString())
+	seed := ""
+	if !g.seed.IsNull() && !g.seed.IsUnknown() {
+		seed = idgen.DeriveSeed(g.seed.ValueString(), fmt.Sprintf("call/%d", g.calls))
+	}
+	value, err := generate(seed)
+	if err != nil {
+		return "", err
 	}
+	g.values[key] = value
+	return value, nil
+}
+
+func (g *inlineGenerators) funcs() template.FuncMap {
+	return template.FuncMap{
+		// {{ nanoid 6 }} or {{ nanoid 6 "readable" }}
+		"nanoid": func(length int, alphabet ...string) (string, error) {
+			if len(alphabet) > 1 {
+				return "", fmt.Errorf("nanoid expects a length and an optional alphabet, got %d arguments", len(alphabet)+1)
+			}
+			config := NanoIDConfig{Length: types.Int64Value(int64(length)), Alphabet: types.StringNull()}
+			if len(alphabet) == 1 {
+				config.Alphabet = types.StringValue(alphabet[0])
+			}
 
-	return idgen.GetWordBySeed(seed, wordlist)
+			return g.call(fmt.Sprintf("nanoid %d %q", length, alphabet), func(seed string) (string, error) {
+				if seed != "" {
+					config.Seed = types.StringValue(seed)
+				}
+
+				var diags diag.Diagnostics
+				id := generateNanoID(templatedNanoIDConfig(config), &diags)
+				if diags.HasError() {
+					return "", fmt.Errorf("nanoid: %s", diags.Errors()[0].Detail())
+				}
+				return id, nil
+			})
+		},
+		// {{ proquint }} or {{ proquint 4 }}: the number of five-letter words (default: 2)
+		"proquint": func(words ...int) (string, error) {
+			if len(words) > 1 {
+				return "", fmt.Errorf("proquint expects an optional number of words, got %d arguments", len(words))
+			}
+			count := 2
+			if len(words) == 1 {
+				count = words[0]
+			}
+			if count < 1 || count > idgen.CanonicalProquintMaxBytes/2 {
+				return "", fmt.Errorf("proquint word count must be between 1 and %d, got %d", idgen.CanonicalProquintMaxBytes/2, count)
+			}
+			return g.call(fmt.Sprintf("proquint %d", count), func(seed string) (string, error) {
+				var random *int64
+				if seed != "" {
+					random = randomSeed(types.StringValue(seed))
+				}
+				return idgen.GenerateProquint(count*2, random, false)
+			})
+		},
+		// {{ word }} or {{ word "apple,banana,cherry" }}: picked like the random_word component,
+		// so unseeded calls are random
+		"word": func(wordlist ...string) (string, error) {
+			if len(wordlist) > 1 {
+				return "", fmt.Errorf("word expects an optional comma-separated word list, got %d arguments", len(wordlist))
+			}
+			config := RandomWordConfig{Wordlist: types.StringNull()}
+			if len(wordlist) == 1 {
+				config.Wordlist = types.StringValue(wordlist[0])
+			}
+
+			return g.call(fmt.Sprintf("word %q", wordlist), func(seed string) (string, error) {
+				if seed != "" {
+					config.Seed = types.StringValue(seed)
+				}
+				return generateRandomWord(config), nil
+			})
+		},
+	}
 }
 
 // templateFuncs returns custom template functions for string manipulation.
 // Functions are pipe-friendly: the piped value is the last parameter.
 func templateFuncs() template.FuncMap {
-	return template.FuncMap{
+	funcs := template.FuncMap{
 		// Case conversion
 		"upper": strings.ToUpper,
 		"lower": strings.ToLower,
@@ -418,6 +1525,23 @@ func templateFuncs() template.FuncMap {
 			return strings.TrimSuffix(s, suffix)
 		},
 
+		// Normalization of free-form text: {{ .random_word | prepend "Team Ärger " | slug }}
+		"transliterate": idgen.Transliterate,
+		"slug":          idgen.Slug,
+		"dns_label":     idgen.DNSLabel,
+		"kebab": func(s string) string {
+			return idgen.JoinWords(s, "-")
+		},
+		"snake": func(s string) string {
+			return idgen.JoinWords(s, "_")
+		},
+		"camel": func(s string) string {
+			return idgen.CamelCase(s, false)
+		},
+		"pascal": func(s string) string {
+			return idgen.CamelCase(s, true)
+		},
+
 		// Repetition and reversal
 		"repeat": func(count int, s string) string {
 			return strings.Repeat(s, count)
@@ -429,5 +1553,52 @@ func templateFuncs() template.FuncMap {
 			}
 			return string(runes)
 		},
+
+		// Length: {{ .nanoid | truncate_hash 20 }}
+		"truncate": func(maxLength int, s string) string {
+			return idgen.Truncate(s, maxLength)
+		},
+		"truncate_hash": func(maxLength int, s string) (string, error) {
+			return idgen.TruncateWithHash(s, maxLength)
+		},
+		"pad_left": func(length int, padChar, s string) (string, error) {
+			return idgen.Pad(s, length, padChar, true)
+		},
+		"pad_right": func(length int, padChar, s string) (string, error) {
+			return idgen.Pad(s, length, padChar, false)
+		},
+
+		// Hashing: {{ .random_word | sha256 }} or {{ .random_word | sha256 "base62" | truncate 8 }}
+		"sha256": hashFunc(idgen.HashSHA256),
+		"sha1":   hashFunc(idgen.HashSHA1),
+		"md5":    hashFunc(idgen.HashMD5),
+		"fnv64":  hashFunc(idgen.HashFNV64),
+		"crc32":  hashFunc(idgen.HashCRC32),
+		"hash_to": func(alphabet string, length int, s string) (string, error) {
+			return idgen.HashTo(s, resolveAlphabet(alphabet), length)
+		},
+
+		// Spoken form: {{ .nanoid | spoken }} or {{ .nanoid | spoken "lapd" }}
+		"spoken": func(args ...string) (string, error) {
+			if len(args) == 0 || len(args) > 2 {
+				return "", fmt.Errorf("spoken expects a value and an optional spelling alphabet, got %d arguments", len(args))
+			}
+			spec := ""
+			if len(args) == 2 {
+				spec = args[0]
+			}
+			alphabet, err := idgen.NewSpellingAlphabet(spec)
+			if err != nil {
+				return "", err
+			}
+			return idgen.Spell(args[len(args)-1], alphabet, true), nil
+		},
 	}
+
+	// Inline generators (unseeded; renderTemplate replaces them with seeded ones)
+	for name, fn := range newInlineGenerators(types.StringNull()).funcs() {
+		funcs[name] = fn
+	}
+
+	return funcs
 }
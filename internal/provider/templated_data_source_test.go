@@ -1,9 +1,12 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccTemplatedDataSource(t *testing.T) {
@@ -35,6 +38,22 @@ func TestAccTemplatedDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "lusab-babad.kufal-zotib.h84-Hs2-ML8-SW.apple"),
 				),
 			},
+			// Test template with typeid
+			{
+				Config: testAccTemplatedDataSourceConfigWithTypeID,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "order_01h3s6spg0fj3vpm54wak4xnpp.apple"),
+				),
+			},
+			// Test template with cuid2
+			{
+				Config: testAccTemplatedDataSourceConfigWithCUID2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "user-hmdzs3sr6011"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "spoken",
+						"uniform-sierra-echo-romeo-dash-hotel-mike-delta-zulu-sierra-three-sierra-romeo-six-zero-one-one"),
+				),
+			},
 			// Test template functions with piping
 			{
 				Config: testAccTemplatedDataSourceConfigWithFunctions,
@@ -43,6 +62,139 @@ func TestAccTemplatedDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "KUFAL_ZOTIB_:apfel-:apfel-"),
 				),
 			},
+			// Test naming profile adapting the ID
+			{
+				Config: testAccTemplatedDataSourceConfigWithNamingProfile,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "order-01h3s6spg0fj3vpm54wak4xnpp.apple"),
+				),
+			},
+			// Test naming profile violation reported on the component
+			{
+				Config:      testAccTemplatedDataSourceConfigWithNamingProfileViolation,
+				ExpectError: regexp.MustCompile(`come from the typeid component`),
+			},
+			// Test normalization functions
+			{
+				Config: testAccTemplatedDataSourceConfigWithNormalization,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "team-aerger-vivid-007"),
+				),
+			},
+			// Test named components of the same type
+			{
+				Config: testAccTemplatedDataSourceConfigWithComponents,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "vivid-windy-636592-CnDxXf"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "parts.%", "4"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "parts.components.code", "636592"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "parts.components.ref", "CnDxXf"),
+				),
+			},
+			// Test named component with an attribute of another type
+			{
+				Config:      testAccTemplatedDataSourceConfigWithComponentsInvalid,
+				ExpectError: regexp.MustCompile(`not supported by nanoid components`),
+			},
+			// Test user-supplied vars with functions and conditionals
+			{
+				Config: testAccTemplatedDataSourceConfigWithVars,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "data-platform-np-vivid"),
+				),
+			},
+			// Test template referencing a component that is not configured
+			{
+				Config:      testAccTemplatedDataSourceConfigUndefinedComponent,
+				ExpectError: regexp.MustCompile(`no nanoid component is configured`),
+			},
+			// Test named templates rendered into outputs
+			{
+				Config: testAccTemplatedDataSourceConfigWithTemplates,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.idgen_templated.test", "id"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "outputs.%", "2"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "outputs.bucket", "vivid-assets"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "outputs.role", "VIVID_ROLE"),
+				),
+			},
+			// Test unseeded components are shared between template and templates
+			{
+				Config: testAccTemplatedDataSourceConfigWithTemplatesUnseeded,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources["data.idgen_templated.test"].Primary.Attributes
+						if attributes["outputs.bucket"] != "assets-"+attributes["id"] || attributes["outputs.tag"] != attributes["id"] ||
+							attributes["parts.nanoid"] != attributes["id"] {
+							return fmt.Errorf("outputs and parts should share the nanoid of id %q, got bucket %q, tag %q and part %q",
+								attributes["id"], attributes["outputs.bucket"], attributes["outputs.tag"], attributes["parts.nanoid"])
+						}
+						return nil
+					},
+				),
+			},
+			// Test inline generators seeded by the top-level seed
+			{
+				Config: testAccTemplatedDataSourceConfigWithInlineGenerators,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "tMesgw-hopap-litoz-suave"),
+				),
+			},
+			// Test component sub-seeds derived from the top-level seed
+			{
+				Config: testAccTemplatedDataSourceConfigWithDerivedSeeds,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "woody-aglow-pTGudi-vivid"),
+				),
+			},
+			// Test batch rendering with per-entry seeds
+			{
+				Config: testAccTemplatedDataSourceConfigWithBatch,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "ids.0", "shard-0-D1ZE"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "ids.1", "shard-1-w8b2"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "ids.2", "shard-2-sDiz"),
+					resource.TestCheckNoResourceAttr("data.idgen_templated.test", "id"),
+				),
+			},
+			// Test batch entries derive sub-seeds of explicit component seeds
+			{
+				Config: testAccTemplatedDataSourceConfigWithSeededComponentBatch,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "ids.0", "shard-vivid-mOSR"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "ids.1", "shard-brisk-ckOh"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "ids.2", "shard-windy-HvHP"),
+				),
+			},
+			// Test duplicate IDs in a batch
+			{
+				Config:      testAccTemplatedDataSourceConfigWithDuplicateBatch,
+				ExpectError: regexp.MustCompile(`Entries 0 and 1 both render`),
+			},
+			// Test hash functions
+			{
+				Config: testAccTemplatedDataSourceConfigWithHash,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "repo-C8FQ6L-vivid"),
+				),
+			},
+			// Test max_length with hash suffix
+			{
+				Config: testAccTemplatedDataSourceConfigWithMaxLength,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "payment-b8be4dd7"),
+				),
+			},
+			// Test nanoid component with check digit
+			{
+				Config: testAccTemplatedDataSourceConfigWithCheckDigit,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "id", "INV-6365-9227-83"),
+					resource.TestCheckResourceAttr("data.idgen_templated.test", "parts.nanoid", "6365-9227-83"),
+				),
+			},
 		},
 	})
 }
@@ -108,6 +260,34 @@ data "idgen_templated" "test" {
 }
 `
 
+const testAccTemplatedDataSourceConfigWithTypeID = `
+data "idgen_templated" "test" {
+  template = "{{ .typeid }}.{{ .random_word }}"
+
+  typeid = {
+    prefix    = "order"
+    seed      = "xyz-12"
+    timestamp = "2023-06-25T12:00:00Z"
+  }
+
+  random_word = {
+    seed     = "0"
+    wordlist = "apple,banana,cherry"
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithCUID2 = `
+data "idgen_templated" "test" {
+  template = "user-{{ .cuid2 }}"
+
+  cuid2 = {
+    length = 12
+    seed   = "xyz-12"
+  }
+}
+`
+
 const testAccTemplatedDataSourceConfigWithFunctions = `
 data "idgen_templated" "test" {
   template = "{{ .proquint | upper | replace \"-\" \"_\" }}_{{ .random_word | reverse | replace \"elppa\" \"apfel\" | append \"-\" | prepend \":\" | repeat 2 }}"
@@ -124,6 +304,229 @@ data "idgen_templated" "test" {
 }
 `
 
+const testAccTemplatedDataSourceConfigWithNamingProfile = `
+data "idgen_templated" "test" {
+  template       = "{{ .typeid }}.{{ .random_word }}"
+  naming_profile = "s3_bucket"
+  naming_mode    = "adapt"
+
+  typeid = {
+    prefix    = "order"
+    seed      = "xyz-12"
+    timestamp = "2023-06-25T12:00:00Z"
+  }
+
+  random_word = {
+    seed     = "0"
+    wordlist = "Apple,Banana,Cherry"
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithNamingProfileViolation = `
+data "idgen_templated" "test" {
+  template       = "{{ .typeid }}.{{ .random_word }}"
+  naming_profile = "s3_bucket"
+
+  typeid = {
+    prefix    = "order"
+    seed      = "xyz-12"
+    timestamp = "2023-06-25T12:00:00Z"
+  }
+
+  random_word = {
+    seed     = "0"
+    wordlist = "apple,banana,cherry"
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithNormalization = `
+data "idgen_templated" "test" {
+  template = "{{ .random_word | prepend \"Team Ärger \" | slug }}-{{ \"7\" | pad_left 3 \"0\" }}"
+
+  random_word = {
+    seed = "17"
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithComponents = `
+data "idgen_templated" "test" {
+  template = "{{ .components.first }}-{{ .components.second }}-{{ .components.code }}-{{ .components.ref }}"
+
+  components = {
+    first  = { type = "random_word", seed = "17" }
+    second = { type = "random_word", seed = "18" }
+    code   = { type = "nanoid", seed = "42", length = 6, alphabet = "numeric" }
+    ref    = { type = "nanoid", seed = "42", length = 6, alphabet = "readable" }
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithComponentsInvalid = `
+data "idgen_templated" "test" {
+  template = "{{ .components.code }}"
+
+  components = {
+    code = { type = "nanoid", wordlist = "apple,banana" }
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithVars = `
+data "idgen_templated" "test" {
+  template = "{{ .vars.team | slug }}-{{ if eq .vars.stage \"prod\" }}p{{ else }}np{{ end }}-{{ .random_word }}"
+
+  vars = {
+    team  = "Data Platform"
+    stage = "dev"
+  }
+
+  random_word = {
+    seed = "17"
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigUndefinedComponent = `
+data "idgen_templated" "test" {
+  template = "{{ .random_word }}-{{ .nanoid }}"
+
+  random_word = {
+    seed = "17"
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithTemplates = `
+data "idgen_templated" "test" {
+  templates = {
+    bucket = "{{ .random_word }}-assets"
+    role   = "{{ .random_word | upper }}_ROLE"
+  }
+
+  random_word = {
+    seed = "17"
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithTemplatesUnseeded = `
+data "idgen_templated" "test" {
+  template = "{{ .nanoid }}"
+
+  templates = {
+    bucket = "assets-{{ .nanoid }}"
+    tag    = "{{ .nanoid }}"
+  }
+
+  nanoid = {
+    length = 8
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithInlineGenerators = `
+data "idgen_templated" "test" {
+  template = "{{ nanoid 6 \"readable\" }}-{{ proquint 2 }}-{{ word }}"
+  seed     = "app"
+}
+`
+
+const testAccTemplatedDataSourceConfigWithDerivedSeeds = `
+data "idgen_templated" "test" {
+  template = "{{ .components.first }}-{{ .components.second }}-{{ .nanoid }}-{{ .random_word }}"
+  seed     = "app"
+
+  components = {
+    first  = { type = "random_word" }
+    second = { type = "random_word" }
+  }
+
+  nanoid = {
+    length = 6
+  }
+
+  random_word = {
+    seed = "17"
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithBatch = `
+data "idgen_templated" "test" {
+  template   = "shard-{{ .index }}-{{ .nanoid }}"
+  seed       = "app"
+  batch_size = 3
+
+  nanoid = {
+    length = 4
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithSeededComponentBatch = `
+data "idgen_templated" "test" {
+  template   = "shard-{{ .random_word }}-{{ .components.suffix }}"
+  batch_size = 3
+
+  random_word = {
+    seed = "17"
+  }
+
+  components = {
+    suffix = { type = "nanoid", length = 4, seed = "17" }
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithDuplicateBatch = `
+data "idgen_templated" "test" {
+  template   = "shard-{{ .vars.env }}"
+  batch_size = 2
+
+  vars = {
+    env = "prod"
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithHash = `
+data "idgen_templated" "test" {
+  template = "repo-{{ \"https://github.com/iilei/terraform-provider-idgen\" | hash_to \"readable\" 6 }}-{{ .random_word }}"
+
+  random_word = {
+    seed = "17"
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithMaxLength = `
+data "idgen_templated" "test" {
+  template   = "payments-service-{{ .random_word }}"
+  max_length = 16
+
+  random_word = {
+    seed = "17"
+  }
+}
+`
+
+const testAccTemplatedDataSourceConfigWithCheckDigit = `
+data "idgen_templated" "test" {
+  template = "INV-{{ .nanoid }}"
+
+  nanoid = {
+    length      = 12
+    group_size  = 4
+    alphabet    = "numeric"
+    check_digit = "damm"
+    seed        = "42"
+  }
+}
+`
+
 func TestAccTemplatedDataSourcePerDocs(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
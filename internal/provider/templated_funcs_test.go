@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
+	"reflect"
 	"strings"
 	"testing"
 	"text/template"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/iilei/terraform-provider-idgen/internal/idgen"
@@ -155,6 +158,74 @@ func TestTemplateFuncs(t *testing.T) {
 			t.Error("trimSuffix function not found")
 		}
 	})
+
+	t.Run("truncate_hash function", func(t *testing.T) {
+		if truncateHashFunc, ok := funcs["truncate_hash"]; ok {
+			fn := truncateHashFunc.(func(int, string) (string, error))
+			result, err := fn(16, "payments-service-vivid")
+			if err != nil || result != "payment-b8be4dd7" {
+				t.Errorf("truncate_hash(16, \"payments-service-vivid\") = %q, %v, want \"payment-b8be4dd7\"", result, err)
+			}
+			if result, _ := fn(16, "vivid"); result != "vivid" {
+				t.Errorf("truncate_hash(16, \"vivid\") = %q, want \"vivid\"", result)
+			}
+			if _, err := fn(5, "payments-service-vivid"); err == nil {
+				t.Error("truncate_hash(5, ...) expected error")
+			}
+		} else {
+			t.Error("truncate_hash function not found")
+		}
+	})
+}
+
+func TestTemplateFuncSpoken(t *testing.T) {
+	tests := []struct {
+		name     string
+		tmplText string
+		expected string
+	}{
+		{"default alphabet", `{{ "b7X" | spoken }}`, "bravo-seven-capital-xray"},
+		{"named alphabet", `{{ "b7X" | spoken "lapd" }}`, "boy-seven-capital-xray"},
+		{"custom alphabet", `{{ spoken "b=bee" "b7" }}`, "bee-seven"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := template.Must(template.New("test").Funcs(templateFuncs()).Parse(tt.tmplText))
+
+			var result strings.Builder
+			if err := tmpl.Execute(&result, nil); err != nil {
+				t.Fatalf("template execute error: %v", err)
+			}
+			if result.String() != tt.expected {
+				t.Errorf("template result = %q, want %q", result.String(), tt.expected)
+			}
+		})
+	}
+
+	t.Run("invalid alphabet", func(t *testing.T) {
+		tmpl := template.Must(template.New("test").Funcs(templateFuncs()).Parse(`{{ "b7" | spoken "klingon" }}`))
+		if err := tmpl.Execute(&strings.Builder{}, nil); err == nil {
+			t.Error("expected error for invalid spelling alphabet, got nil")
+		}
+	})
+}
+
+func TestSpellID(t *testing.T) {
+	var diags diag.Diagnostics
+	if got := spellID("Ab-1", types.StringNull(), true, &diags); got.ValueString() != "capital-alfa-bravo-dash-one" {
+		t.Errorf("spellID() = %q, want %q", got.ValueString(), "capital-alfa-bravo-dash-one")
+	}
+	if got := spellID("Ab-1", types.StringValue("lapd"), false, &diags); got.ValueString() != "adam-boy-dash-one" {
+		t.Errorf("spellID() = %q, want %q", got.ValueString(), "adam-boy-dash-one")
+	}
+	if diags.HasError() {
+		t.Fatalf("spellID() unexpected errors: %v", diags.Errors())
+	}
+
+	if got := spellID("Ab", types.StringValue("a:apple"), false, &diags); !got.IsNull() || !diags.HasError() {
+		t.Errorf("spellID() with invalid alphabet = %v, errors = %d, want null value and an error", got, len(diags.Errors()))
+	}
 }
 
 func TestTemplateFuncIntegration(t *testing.T) {
@@ -178,6 +249,93 @@ func TestTemplateFuncIntegration(t *testing.T) {
 	}
 }
 
+func TestTemplateFuncsNormalization(t *testing.T) {
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{`{{ "Jürgen Groß" | transliterate }}`, "Juergen Gross"},
+		{`{{ .random_word | prepend "Team Ärger " | slug }}`, "team-aerger-vivid"},
+		{`{{ "Payments API (EU-West)" | dns_label }}`, "payments-api-eu-west"},
+		{`{{ .random_word | prepend "dataPlatform " | kebab }}`, "data-platform-vivid"},
+		{`{{ .random_word | prepend "Data Platform " | snake }}`, "data_platform_vivid"},
+		{`{{ .random_word | prepend "Data Platform " | camel }}`, "dataPlatformVivid"},
+		{`{{ .random_word | prepend "data-platform-" | pascal }}`, "DataPlatformVivid"},
+		{`{{ .random_word | truncate 3 }}`, "viv"},
+		{`{{ .random_word | truncate 10 }}`, "vivid"},
+		{`{{ .random_word | pad_left 8 "0" }}`, "000vivid"},
+		{`{{ .random_word | pad_right 8 "_" }}`, "vivid___"},
+	}
+
+	for _, tt := range tests {
+		tmpl, err := template.New("test").Funcs(templateFuncs()).Parse(tt.template)
+		if err != nil {
+			t.Fatalf("template parse error: %v", err)
+		}
+
+		var result strings.Builder
+		if err := tmpl.Execute(&result, map[string]string{"random_word": "vivid"}); err != nil {
+			t.Errorf("template %s execute error: %v", tt.template, err)
+			continue
+		}
+		if result.String() != tt.expected {
+			t.Errorf("template %s = %q, want %q", tt.template, result.String(), tt.expected)
+		}
+	}
+
+	for _, invalid := range []string{`{{ "---" | dns_label }}`, `{{ "42" | pad_left 5 "00" }}`} {
+		tmpl := template.Must(template.New("test").Funcs(templateFuncs()).Parse(invalid))
+		if err := tmpl.Execute(&strings.Builder{}, nil); err == nil {
+			t.Errorf("template %s expected error", invalid)
+		}
+	}
+}
+
+func TestTemplateFuncsHashing(t *testing.T) {
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{`{{ .random_word | sha256 }}`, "685ee53b55aa432dc84736bff82a5ac5c8aaf1a7c8c4e62699bf040764bcd364"},
+		{`{{ .random_word | sha1 }}`, "a0d73f624ffe9961be6dfc3fa365f8fedc6a4ced"},
+		{`{{ .random_word | md5 "base32" }}`, "vptcdfddfwvdh6yb6sblkkucfy"},
+		{`{{ .random_word | fnv64 "base62" }}`, "ihWyGF55TTT"},
+		{`{{ .random_word | crc32 "readable" }}`, "6Jekzk"},
+		{`{{ .random_word | sha256 "base62" | truncate 8 }}`, "yUDfXZmP"},
+		{`{{ .random_word | hash_to "readable" 8 }}`, "G73pT8ts"},
+		{`{{ .random_word | hash_to "numeric" 4 }}`, "1172"},
+		{`{{ .random_word | hash_to "abcdef" 6 }}`, "fdccee"},
+	}
+
+	for _, tt := range tests {
+		tmpl, err := template.New("test").Funcs(templateFuncs()).Parse(tt.template)
+		if err != nil {
+			t.Fatalf("template parse error: %v", err)
+		}
+
+		var result strings.Builder
+		if err := tmpl.Execute(&result, map[string]string{"random_word": "vivid"}); err != nil {
+			t.Errorf("template %s execute error: %v", tt.template, err)
+			continue
+		}
+		if result.String() != tt.expected {
+			t.Errorf("template %s = %q, want %q", tt.template, result.String(), tt.expected)
+		}
+	}
+
+	for _, invalid := range []string{
+		`{{ "vivid" | sha256 "base64" }}`,
+		`{{ sha256 }}`,
+		`{{ "vivid" | hash_to "alphanumeric" 43 }}`,
+		`{{ "vivid" | hash_to "aab" 4 }}`,
+	} {
+		tmpl := template.Must(template.New("test").Funcs(templateFuncs()).Parse(invalid))
+		if err := tmpl.Execute(&strings.Builder{}, nil); err == nil {
+			t.Errorf("template %s expected error", invalid)
+		}
+	}
+}
+
 func TestGenerateProquintCanonical_ErrorPaths(t *testing.T) {
 	// These tests target the missing coverage in generateProquintCanonical
 
@@ -231,8 +389,8 @@ func TestGenerateProquintCanonical_ErrorPaths(t *testing.T) {
 		// Check error message
 		if len(diags.Errors()) > 0 {
 			errMsg := diags.Errors()[0].Summary()
-			if errMsg != "Invalid seed for canonical proquint" {
-				t.Errorf("Expected error 'Invalid seed for canonical proquint', got: %q", errMsg)
+			if errMsg != "Invalid seed for canonical encoding" {
+				t.Errorf("Expected error 'Invalid seed for canonical encoding', got: %q", errMsg)
 			}
 		}
 	})
@@ -295,9 +453,9 @@ func TestGenerateNanoID_AlphabetCases(t *testing.T) {
 			Seed:     types.StringValue("test-seed"),
 		}
 
-		result, err := generateNanoID(config, &diags)
-		if err != nil {
-			t.Fatalf("generateNanoID failed: %v", err)
+		result := generateNanoID(config, &diags)
+		if diags.HasError() {
+			t.Fatalf("generateNanoID failed: %v", diags.Errors())
 		}
 
 		if result == "" {
@@ -325,9 +483,9 @@ func TestGenerateNanoID_AlphabetCases(t *testing.T) {
 			Seed:     types.StringValue("test-seed"),
 		}
 
-		result, err := generateNanoID(config, &diags)
-		if err != nil {
-			t.Fatalf("generateNanoID failed: %v", err)
+		result := generateNanoID(config, &diags)
+		if diags.HasError() {
+			t.Fatalf("generateNanoID failed: %v", diags.Errors())
 		}
 
 		if result == "" {
@@ -356,9 +514,9 @@ func TestGenerateNanoID_AlphabetCases(t *testing.T) {
 			Seed:     types.StringValue("test-seed"),
 		}
 
-		result, err := generateNanoID(config, &diags)
-		if err != nil {
-			t.Fatalf("generateNanoID failed: %v", err)
+		result := generateNanoID(config, &diags)
+		if diags.HasError() {
+			t.Fatalf("generateNanoID failed: %v", diags.Errors())
 		}
 
 		if result == "" {
@@ -387,9 +545,9 @@ func TestGenerateNanoID_AlphabetCases(t *testing.T) {
 			Seed:     types.StringValue("test-seed"),
 		}
 
-		result, err := generateNanoID(config, &diags)
-		if err != nil {
-			t.Fatalf("generateNanoID failed: %v", err)
+		result := generateNanoID(config, &diags)
+		if diags.HasError() {
+			t.Fatalf("generateNanoID failed: %v", diags.Errors())
 		}
 
 		// Should use alphanumeric alphabet (case-insensitive match)
@@ -401,10 +559,9 @@ func TestGenerateNanoID_AlphabetCases(t *testing.T) {
 
 		// Test "NUMERIC" as well
 		config.Alphabet = types.StringValue("NUMERIC")
-		result2, err2 := generateNanoID(config, &diags)
-
-		if err2 != nil {
-			t.Fatalf("generateNanoID failed: %v", err2)
+		result2 := generateNanoID(config, &diags)
+		if diags.HasError() {
+			t.Fatalf("generateNanoID failed: %v", diags.Errors())
 		}
 
 		for _, char := range result2 {
@@ -413,6 +570,56 @@ func TestGenerateNanoID_AlphabetCases(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("default alphabet is readable", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		config := NanoIDConfig{
+			Length: types.Int64Value(21),
+			Seed:   types.StringValue("test-seed"),
+		}
+
+		result := generateNanoID(config, &diags)
+		if diags.HasError() {
+			t.Fatalf("generateNanoID failed: %v", diags.Errors())
+		}
+
+		for _, char := range result {
+			if !strings.ContainsRune(idgen.Readable, char) {
+				t.Errorf("Character %c not in readable alphabet", char)
+			}
+		}
+	})
+
+	t.Run("templated default alphabet is alphanumeric", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		config := templatedNanoIDConfig(NanoIDConfig{
+			Length: types.Int64Value(21),
+			Seed:   types.StringValue("test-seed"),
+		})
+
+		// Pinned to the output templated IDs had before the readable default of idgen_nanoid
+		if result := generateNanoID(config, &diags); result != "X6sE8BrxOQUwMT50zQd9B" {
+			t.Errorf("generateNanoID() = %q, want X6sE8BrxOQUwMT50zQd9B", result)
+		}
+		if diags.HasError() {
+			t.Fatalf("generateNanoID failed: %v", diags.Errors())
+		}
+	})
+
+	t.Run("invalid length error", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		config := NanoIDConfig{
+			Length: types.Int64Value(0),
+			Seed:   types.StringValue("test-seed"),
+		}
+
+		if result := generateNanoID(config, &diags); result != "" || !diags.HasError() {
+			t.Errorf("Expected error for length 0, got %q", result)
+		}
+	})
 }
 
 func TestGenerateNanoID_DashWarning(t *testing.T) {
@@ -427,9 +634,9 @@ func TestGenerateNanoID_DashWarning(t *testing.T) {
 			Seed:      types.StringValue("test-seed"),
 		}
 
-		result, err := generateNanoID(config, &diags)
-		if err != nil {
-			t.Fatalf("generateNanoID failed: %v", err)
+		result := generateNanoID(config, &diags)
+		if diags.HasError() {
+			t.Fatalf("generateNanoID failed: %v", diags.Errors())
 		}
 
 		if result == "" {
@@ -460,9 +667,9 @@ func TestGenerateNanoID_DashWarning(t *testing.T) {
 			Seed:      types.StringValue("test-seed"),
 		}
 
-		result, err := generateNanoID(config, &diags)
-		if err != nil {
-			t.Fatalf("generateNanoID failed: %v", err)
+		result := generateNanoID(config, &diags)
+		if diags.HasError() {
+			t.Fatalf("generateNanoID failed: %v", diags.Errors())
 		}
 
 		if result == "" {
@@ -479,13 +686,15 @@ func TestGenerateNanoID_DashWarning(t *testing.T) {
 func TestGenerateProquint_EdgeCases(t *testing.T) {
 	// Test missing coverage in generateProquint
 	t.Run("very small length calculation", func(t *testing.T) {
+		var diags diag.Diagnostics
+
 		config := ProquintConfig{
 			Length:    types.Int64Value(1), // Very small length
 			Seed:      types.StringValue("test-seed"),
 			GroupSize: types.Int64Value(5),
 		}
 
-		result := generateProquint(config)
+		result := generateProquint(config, false, &diags)
 
 		if result == "" {
 			t.Error("Expected non-empty result even with small length")
@@ -493,13 +702,15 @@ func TestGenerateProquint_EdgeCases(t *testing.T) {
 	})
 
 	t.Run("zero group size skips grouping logic", func(t *testing.T) {
+		var diags diag.Diagnostics
+
 		config := ProquintConfig{
 			Length:    types.Int64Value(11),
 			Seed:      types.StringValue("test-seed"),
 			GroupSize: types.Int64Value(0), // Zero group size
 		}
 
-		result := generateProquint(config)
+		result := generateProquint(config, false, &diags)
 
 		if result == "" {
 			t.Error("Expected non-empty result")
@@ -510,4 +721,705 @@ func TestGenerateProquint_EdgeCases(t *testing.T) {
 		// The result should be whatever the raw proquint generation returns
 		t.Logf("Result with group size 0: %s", result)
 	})
+
+	t.Run("invalid length error", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		config := ProquintConfig{
+			Length: types.Int64Value(0),
+			Seed:   types.StringValue("test-seed"),
+		}
+
+		if result := generateProquint(config, false, &diags); result != "" || !diags.HasError() {
+			t.Errorf("Expected error for length 0, got %q", result)
+		}
+	})
+
+	t.Run("IPv4 seed is encoded directly like idgen_proquint", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		config := ProquintConfig{
+			Length: types.Int64Null(),
+			Seed:   types.StringValue("127.0.0.1"),
+		}
+
+		if result := generateProquint(config, true, &diags); result != "lusab-babad" {
+			t.Errorf("generateProquint() = %q, want lusab-babad", result)
+		}
+		if diags.HasError() || len(diags.Warnings()) > 0 {
+			t.Errorf("Unexpected diagnostics: %v", diags)
+		}
+	})
+
+	t.Run("IPv4 seed is hashed for templated components", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		config := ProquintConfig{
+			Length: types.Int64Null(),
+			Seed:   types.StringValue("127.0.0.1"),
+		}
+
+		if result := generateProquint(config, false, &diags); result != "rojal-lizod" {
+			t.Errorf("generateProquint() = %q, want rojal-lizod", result)
+		}
+		if diags.HasError() || len(diags.Warnings()) > 0 {
+			t.Errorf("Unexpected diagnostics: %v", diags)
+		}
+	})
+}
+
+func TestGenerateTypeID_ErrorPaths(t *testing.T) {
+	t.Run("invalid prefix error", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		config := TypeIDConfig{
+			Prefix:    types.StringValue("Order"),
+			Seed:      types.StringNull(),
+			Timestamp: types.StringNull(),
+		}
+
+		result := generateTypeID(config, &diags)
+
+		if result != "" {
+			t.Errorf("Expected empty result for invalid prefix, got: %q", result)
+		}
+		if len(diags.Errors()) == 0 || diags.Errors()[0].Summary() != "Invalid TypeID prefix" {
+			t.Errorf("Expected error 'Invalid TypeID prefix', got: %v", diags.Errors())
+		}
+	})
+
+	t.Run("invalid timestamp error", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		config := TypeIDConfig{
+			Prefix:    types.StringValue("order"),
+			Seed:      types.StringNull(),
+			Timestamp: types.StringValue("2023-06-25"),
+		}
+
+		result := generateTypeID(config, &diags)
+
+		if result != "" {
+			t.Errorf("Expected empty result for invalid timestamp, got: %q", result)
+		}
+		if len(diags.Errors()) == 0 || diags.Errors()[0].Summary() != "Invalid timestamp" {
+			t.Errorf("Expected error 'Invalid timestamp', got: %v", diags.Errors())
+		}
+	})
+
+	t.Run("seeded with timestamp is deterministic", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		config := TypeIDConfig{
+			Prefix:    types.StringValue("order"),
+			Seed:      types.StringValue("xyz-12"),
+			Timestamp: types.StringValue("2023-06-25T12:00:00Z"),
+		}
+
+		result := generateTypeID(config, &diags)
+
+		if diags.HasError() {
+			t.Errorf("Unexpected error: %v", diags.Errors())
+		}
+		if result != "order_01h3s6spg0fj3vpm54wak4xnpp" {
+			t.Errorf("generateTypeID() = %q, want %q", result, "order_01h3s6spg0fj3vpm54wak4xnpp")
+		}
+	})
+}
+
+func TestGenerateRandomWord(t *testing.T) {
+	seeded := RandomWordConfig{Seed: types.StringValue("1"), Wordlist: types.StringValue("apple,banana,cherry")}
+	if word := generateRandomWord(seeded); word != "banana" {
+		t.Errorf("generateRandomWord(seed 1) = %q, want banana", word)
+	}
+
+	// Like unseeded nanoids, unseeded words are random
+	seen := make(map[string]bool)
+	for i := 0; i < 20; i++ {
+		seen[generateRandomWord(RandomWordConfig{Seed: types.StringNull(), Wordlist: types.StringNull()})] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("generateRandomWord() without seed returned only %v", seen)
+	}
+}
+
+func TestGenerateCUID2_Config(t *testing.T) {
+	t.Run("null length uses default", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		config := CUID2Config{
+			Length: types.Int64Null(),
+			Seed:   types.StringValue("42"),
+		}
+
+		result := generateCUID2(config, &diags)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags.Errors())
+		}
+		if len(result) != idgen.CUID2DefaultLength {
+			t.Errorf("Expected length %d, got %d (%q)", idgen.CUID2DefaultLength, len(result), result)
+		}
+	})
+
+	t.Run("invalid length error", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		config := CUID2Config{
+			Length: types.Int64Value(1),
+			Seed:   types.StringNull(),
+		}
+
+		generateCUID2(config, &diags)
+		if len(diags.Errors()) == 0 || diags.Errors()[0].Summary() != "Invalid length" {
+			t.Errorf("Expected error 'Invalid length', got: %v", diags.Errors())
+		}
+	})
+}
+
+func TestTemplateComponentSpans(t *testing.T) {
+	components := map[string]string{"proquint": "kufal-zotib", "nanoid": "h84H"}
+
+	spans := templateComponentSpans(`my_{{ .proquint }}-{{ .nanoid | prepend "n" }}`, components, nil, nil, types.StringNull(), "my_kufal-zotib-nh84H")
+	expected := []templateComponentSpan{{"proquint", 3, 14}, {"nanoid", 16, 20}}
+	if len(spans) != len(expected) {
+		t.Fatalf("templateComponentSpans() = %v, want %v", spans, expected)
+	}
+	for i := range spans {
+		if spans[i] != expected[i] {
+			t.Errorf("templateComponentSpans()[%d] = %v, want %v", i, spans[i], expected[i])
+		}
+	}
+
+	// Components map entries are located by their full variable name
+	named := map[string]string{"components.first": "vivid", "components.second": "windy"}
+	spans = templateComponentSpans(`{{ .components.first }}.{{ .components.second }}`, named, nil, nil, types.StringNull(), "vivid.windy")
+	expected = []templateComponentSpan{{"components.first", 0, 5}, {"components.second", 6, 11}}
+	if len(spans) != len(expected) || spans[0] != expected[0] || spans[1] != expected[1] {
+		t.Errorf("templateComponentSpans() = %v, want %v", spans, expected)
+	}
+
+	// Vars are rendered with their values, like template text
+	vars := map[string]string{"stage": "dev"}
+	spans = templateComponentSpans(`{{ if eq .vars.stage "prod" }}p{{ else }}np{{ end }}-{{ .nanoid }}`, components, vars, nil, types.StringNull(), "np-h84H")
+	if len(spans) != 1 || spans[0] != (templateComponentSpan{"nanoid", 3, 7}) {
+		t.Errorf("templateComponentSpans() = %v, want [{nanoid 3 7}]", spans)
+	}
+
+	// Transformed variables cannot be located
+	if spans := templateComponentSpans(`{{ .nanoid | upper }}`, components, nil, nil, types.StringNull(), "H84H"); spans != nil {
+		t.Errorf("templateComponentSpans() = %v, want nil for transformed variable", spans)
+	}
+	if spans := templateComponentSpans(`team-{{ .nanoid | kebab }}`, components, nil, nil, types.StringNull(), "team-h84-h"); spans != nil {
+		t.Errorf("templateComponentSpans() = %v, want nil for normalized variable", spans)
+	}
+	if spans := templateComponentSpans(`{{ .nanoid | reverse }}`, components, nil, nil, types.StringNull(), "H48h"); spans != nil {
+		t.Errorf("templateComponentSpans() = %v, want nil for reversed variable", spans)
+	}
+}
+
+func TestFinalizeTemplatedID(t *testing.T) {
+	components := map[string]string{"proquint": "kufal-zotib", "nanoid": "h84H"}
+	templateStr := "my_{{ .proquint }}-{{ .nanoid }}"
+	rendered := "my_kufal-zotib-h84H"
+
+	config := func(profile, mode string, maxLength int64, strategy string) TemplatedDataSourceModel {
+		data := TemplatedDataSourceModel{
+			NamingProfile:    types.StringNull(),
+			NamingMode:       types.StringNull(),
+			MaxLength:        types.Int64Null(),
+			TruncateStrategy: types.StringNull(),
+		}
+		if profile != "" {
+			data.NamingProfile = types.StringValue(profile)
+		}
+		if mode != "" {
+			data.NamingMode = types.StringValue(mode)
+		}
+		if maxLength != 0 {
+			data.MaxLength = types.Int64Value(maxLength)
+		}
+		if strategy != "" {
+			data.TruncateStrategy = types.StringValue(strategy)
+		}
+		return data
+	}
+
+	t.Run("violations point at the offending component", func(t *testing.T) {
+		var diags diag.Diagnostics
+		finalizeTemplatedID(rendered, templateStr, components, nil, nil, config(idgen.NamingProfileS3Bucket, "", 0, ""), &diags)
+
+		if diags.ErrorsCount() != 2 {
+			t.Fatalf("finalizeTemplatedID() errors = %v, want 2", diags.Errors())
+		}
+		for i, wantPath := range []string{"template", "nanoid"} {
+			d, ok := diags.Errors()[i].(diag.DiagnosticWithPath)
+			if !ok || d.Path().String() != wantPath {
+				t.Errorf("finalizeTemplatedID() error %d path = %v, want %s", i, d.Path(), wantPath)
+			}
+		}
+		if !strings.Contains(diags.Errors()[1].Detail(), `character 19 ('H')`) {
+			t.Errorf("finalizeTemplatedID() detail = %q, want offending character", diags.Errors()[1].Detail())
+		}
+	})
+
+	t.Run("violations in batch entries point at the offending component", func(t *testing.T) {
+		var diags diag.Diagnostics
+		index := 1
+		finalizeTemplatedID("shard-1-h84H", `shard-{{ .index }}-{{ .nanoid }}`, map[string]string{"nanoid": "h84H"}, nil, &index, config(idgen.NamingProfileK8sDNSLabel, "", 0, ""), &diags)
+
+		if diags.ErrorsCount() != 1 {
+			t.Fatalf("finalizeTemplatedID() errors = %v, want 1", diags.Errors())
+		}
+		if d, ok := diags.Errors()[0].(diag.DiagnosticWithPath); !ok || d.Path().String() != "nanoid" {
+			t.Errorf("finalizeTemplatedID() error path = %v, want nanoid", diags.Errors()[0])
+		}
+	})
+
+	t.Run("adapt", func(t *testing.T) {
+		var diags diag.Diagnostics
+		id := finalizeTemplatedID(rendered, templateStr, components, nil, nil, config(idgen.NamingProfileAzureStorageAccount, namingModeAdapt, 0, ""), &diags)
+		if diags.HasError() || id != "mykufalzotibh84h" {
+			t.Errorf("finalizeTemplatedID() = %q, %v, want mykufalzotibh84h", id, diags)
+		}
+	})
+
+	t.Run("length violations remain after adapting", func(t *testing.T) {
+		var diags diag.Diagnostics
+		finalizeTemplatedID("ab", "ab", nil, nil, nil, config(idgen.NamingProfileGCPProjectID, namingModeAdapt, 0, ""), &diags)
+		if diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Detail(), "minimum is 6") {
+			t.Errorf("finalizeTemplatedID() errors = %v, want minimum length violation", diags.Errors())
+		}
+	})
+
+	t.Run("truncation", func(t *testing.T) {
+		tests := []struct {
+			strategy string
+			expected string
+		}{
+			{"", "my_kufal-c10b84cd"},
+			{truncateStrategyHash, "my_kufal-c10b84cd"},
+			{truncateStrategyCut, "my_kufal-zotib-h8"},
+		}
+		for _, tt := range tests {
+			var diags diag.Diagnostics
+			id := finalizeTemplatedID(rendered, templateStr, components, nil, nil, config("", "", 17, tt.strategy), &diags)
+			if diags.HasError() || id != tt.expected {
+				t.Errorf("finalizeTemplatedID(%q) = %q, %v, want %q", tt.strategy, id, diags, tt.expected)
+			}
+		}
+
+		var diags diag.Diagnostics
+		finalizeTemplatedID(rendered, templateStr, components, nil, nil, config("", "", 17, truncateStrategyError), &diags)
+		if diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Detail(), "19 characters long, the maximum is 17") {
+			t.Errorf("finalizeTemplatedID() errors = %v, want maximum length error", diags.Errors())
+		}
+	})
+
+	t.Run("truncation happens between adapting and validating", func(t *testing.T) {
+		var diags diag.Diagnostics
+		id := finalizeTemplatedID(rendered, templateStr, components, nil, nil, config(idgen.NamingProfileK8sDNSLabel, namingModeAdapt, 17, ""), &diags)
+		if diags.HasError() || id != "my-kufal-49cffcaa" {
+			t.Errorf("finalizeTemplatedID() = %q, %v, want my-kufal-49cffcaa", id, diags)
+		}
+	})
+
+	t.Run("hash is appended without a dash the profile does not allow", func(t *testing.T) {
+		var diags diag.Diagnostics
+		id := finalizeTemplatedID(rendered, templateStr, components, nil, nil, config(idgen.NamingProfileAzureStorageAccount, namingModeAdapt, 12, truncateStrategyHash), &diags)
+		if diags.HasError() || id != "myku06b37b1e" {
+			t.Errorf("finalizeTemplatedID() = %q, %v, want myku06b37b1e", id, diags)
+		}
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		for _, data := range []TemplatedDataSourceModel{
+			config("aws_lambda", "", 0, ""),
+			config(idgen.NamingProfileS3Bucket, "fix", 0, ""),
+			config("", "", 9, ""),
+			config("", "", 0, ""),
+			config("", "", 17, "ellipsis"),
+		} {
+			if data.MaxLength.IsNull() && data.NamingProfile.IsNull() {
+				data.MaxLength = types.Int64Value(0)
+			}
+			var diags diag.Diagnostics
+			finalizeTemplatedID(rendered, templateStr, components, nil, nil, data, &diags)
+			if diags.ErrorsCount() != 1 {
+				t.Errorf("finalizeTemplatedID(%+v) errors = %v, want 1", data, diags.Errors())
+			}
+		}
+	})
+}
+
+func TestTemplateData(t *testing.T) {
+	data := templateData(map[string]string{"nanoid": "h84H", "components.first": "vivid", "components.second": "windy"}, nil)
+
+	if data["nanoid"] != "h84H" {
+		t.Errorf("templateData()[nanoid] = %v, want h84H", data["nanoid"])
+	}
+	components, ok := data["components"].(map[string]string)
+	if !ok || len(components) != 2 || components["first"] != "vivid" || components["second"] != "windy" {
+		t.Errorf("templateData()[components] = %v, want map[first:vivid second:windy]", data["components"])
+	}
+
+	if _, ok := templateData(map[string]string{"nanoid": "h84H"}, nil)["components"]; ok {
+		t.Error("templateData() should not add components without entries")
+	}
+
+	withVars := templateData(map[string]string{"nanoid": "h84H"}, map[string]string{"stage": "prod"})
+	if vars, ok := withVars["vars"].(map[string]string); !ok || vars["stage"] != "prod" {
+		t.Errorf("templateData()[vars] = %v, want map[stage:prod]", withVars["vars"])
+	}
+}
+
+func TestGenerateTemplateComponent(t *testing.T) {
+	component := func(componentType string) TemplateComponentConfig {
+		return TemplateComponentConfig{
+			Type:       types.StringValue(componentType),
+			Seed:       types.StringValue("42"),
+			Length:     types.Int64Null(),
+			GroupSize:  types.Int64Null(),
+			Alphabet:   types.StringNull(),
+			CheckDigit: types.StringNull(),
+			Wordlist:   types.StringNull(),
+			Prefix:     types.StringNull(),
+			Timestamp:  types.StringNull(),
+		}
+	}
+
+	t.Run("generates by type", func(t *testing.T) {
+		config := component("nanoid")
+		config.Length = types.Int64Value(6)
+		config.Alphabet = types.StringValue("numeric")
+
+		var diags diag.Diagnostics
+		id, ok := generateTemplateComponent("code", config, &diags)
+		if !ok || diags.HasError() {
+			t.Fatalf("Unexpected errors: %v", diags)
+		}
+		if id != "636592" {
+			t.Errorf("Expected 636592, got %q", id)
+		}
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		var diags diag.Diagnostics
+		if _, ok := generateTemplateComponent("code", component("ulid"), &diags); ok || !diags.HasError() {
+			t.Error("Expected error for unknown component type")
+		}
+	})
+
+	t.Run("unsupported attribute", func(t *testing.T) {
+		config := component("random_word")
+		config.Alphabet = types.StringValue("numeric")
+
+		var diags diag.Diagnostics
+		if _, ok := generateTemplateComponent("word", config, &diags); ok || !diags.HasError() {
+			t.Fatal("Expected error for attribute not supported by the type")
+		}
+		if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "'alphabet' is not supported by random_word components") {
+			t.Errorf("Unexpected error detail: %s", detail)
+		}
+	})
+
+	t.Run("generation error", func(t *testing.T) {
+		config := component("proquint_canonical")
+		config.Seed = types.StringValue("not-canonical")
+
+		var diags diag.Diagnostics
+		if _, ok := generateTemplateComponent("host", config, &diags); ok || !diags.HasError() {
+			t.Fatal("Expected error for invalid canonical seed")
+		}
+		withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(path.Root("components").AtMapKey("host")) {
+			t.Errorf("Expected error on components[\"host\"], got %v", diags.Errors()[0])
+		}
+	})
+}
+
+func TestTemplateReferences(t *testing.T) {
+	tests := []struct {
+		template string
+		expected []string
+	}{
+		{`{{ .proquint }}-{{ .nanoid | upper }}-{{ .proquint }}`, []string{"proquint", "nanoid"}},
+		{`{{ .components.first }}-{{ index .components "second-word" }}`, []string{"components.first", "components.second-word"}},
+		{`{{ if eq .vars.stage "prod" }}{{ .nanoid }}{{ else }}{{ .cuid2 }}{{ end }}`, []string{"vars.stage", "nanoid", "cuid2"}},
+		{`{{ range $k, $v := .components }}{{ $v }}{{ .ignored }}{{ $.typeid }}{{ end }}`, []string{"components", "typeid"}},
+		{`{{ with .vars }}{{ .stage }}{{ else }}{{ .random_word }}{{ end }}`, []string{"vars", "random_word"}},
+		{`{{ define "suffix" }}{{ .cuid2 }}{{ end }}{{ .nanoid }}-{{ template "suffix" . }}`, []string{"nanoid", "cuid2"}},
+		{`static`, nil},
+	}
+
+	for _, tt := range tests {
+		tmpl := template.Must(template.New("id").Funcs(templateFuncs()).Parse(tt.template))
+		got := templateReferences(tmpl)
+		if strings.Join(got, ",") != strings.Join(tt.expected, ",") {
+			t.Errorf("templateReferences(%s) = %v, want %v", tt.template, got, tt.expected)
+		}
+	}
+}
+
+func TestValidateTemplateReferences(t *testing.T) {
+	variables := func(configured ...string) templateVariables {
+		v := templateVariables{configured: make(map[string]bool), unknown: make(map[string]bool)}
+		for _, name := range configured {
+			v.configured[name] = true
+		}
+		return v
+	}
+
+	tests := []struct {
+		name      string
+		template  string
+		variables templateVariables
+		errors    []string
+		warnings  []string
+	}{
+		{
+			name:      "all configured and used",
+			template:  `{{ .nanoid }}-{{ .components.first }}-{{ .vars.stage }}`,
+			variables: variables("nanoid", "components.first", "vars.stage", "vars.unused"),
+		},
+		{
+			name:     "invalid template",
+			template: `{{ .nanoid `,
+			errors:   []string{"unclosed action"},
+		},
+		{
+			name:      "missing component",
+			template:  `{{ .proquint }}-{{ .nanoid }}`,
+			variables: variables("proquint"),
+			errors:    []string{"no nanoid component is configured"},
+		},
+		{
+			name:      "missing entries",
+			template:  `{{ .components.second }}-{{ .vars.stage }}-{{ .vars }}`,
+			variables: variables("components.first"),
+			errors:    []string{`components has no entry "second"`, `vars has no entry "stage"`, "vars is not set"},
+			warnings:  []string{"components.first component is configured"},
+		},
+		{
+			name:      "unknown variable",
+			template:  `{{ .nanoId }}`,
+			variables: variables("nanoid"),
+			errors:    []string{".nanoId, which is not a template variable"},
+			warnings:  []string{"nanoid component is configured, but no template uses .nanoid"},
+		},
+		{
+			name:      "components used as a whole",
+			template:  `{{ range .components }}{{ . }}{{ end }}`,
+			variables: variables("components.first", "components.second"),
+		},
+		{
+			name:      "unknown entries",
+			template:  `{{ .components.first }}`,
+			variables: templateVariables{configured: map[string]bool{}, unknown: map[string]bool{"components": true}},
+		},
+		{
+			name:      "batch index",
+			template:  `shard-{{ .index }}-{{ .nanoid }}`,
+			variables: variables("nanoid", "index"),
+		},
+		{
+			name:      "batch index unused",
+			template:  `{{ .nanoid }}`,
+			variables: variables("nanoid", "index"),
+		},
+		{
+			name:     "index without batch",
+			template: `shard-{{ .index }}`,
+			errors:   []string{"references .index, but batch_size is not set"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.variables.configured == nil {
+				tt.variables = variables()
+			}
+
+			var diags diag.Diagnostics
+			validateTemplateReferences([]templateSource{{path: path.Root("template"), text: tt.template}}, tt.variables, &diags)
+
+			if len(diags.Errors()) != len(tt.errors) {
+				t.Fatalf("errors = %v, want %d", diags.Errors(), len(tt.errors))
+			}
+			for i, want := range tt.errors {
+				if detail := diags.Errors()[i].Detail(); !strings.Contains(detail, want) {
+					t.Errorf("error %d = %q, want %q", i, detail, want)
+				}
+			}
+			if len(diags.Warnings()) != len(tt.warnings) {
+				t.Fatalf("warnings = %v, want %d", diags.Warnings(), len(tt.warnings))
+			}
+			for i, want := range tt.warnings {
+				if detail := diags.Warnings()[i].Detail(); !strings.Contains(detail, want) {
+					t.Errorf("warning %d = %q, want %q", i, detail, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateTemplateReferences_MultipleTemplates(t *testing.T) {
+	variables := templateVariables{
+		configured: map[string]bool{"nanoid": true, "random_word": true},
+		unknown:    map[string]bool{},
+	}
+	sources := []templateSource{
+		{path: path.Root("template"), text: `{{ .nanoid }}`},
+		{path: path.Root("templates").AtMapKey("role"), text: `role-{{ .random_word }}`},
+		{path: path.Root("templates").AtMapKey("tag"), text: `{{ .cuid2 }}`},
+	}
+
+	var diags diag.Diagnostics
+	validateTemplateReferences(sources, variables, &diags)
+
+	// Components used by any template are not reported as unused
+	if len(diags.Warnings()) != 0 {
+		t.Errorf("warnings = %v, want none", diags.Warnings())
+	}
+	if len(diags.Errors()) != 1 {
+		t.Fatalf("errors = %v, want 1", diags.Errors())
+	}
+	errorPath := diags.Errors()[0].(diag.DiagnosticWithPath).Path()
+	if want := path.Root("templates").AtMapKey("tag"); !errorPath.Equal(want) {
+		t.Errorf("error path = %s, want %s", errorPath, want)
+	}
+}
+
+func TestComponentSeed(t *testing.T) {
+	seed := types.StringValue("app")
+
+	if got := componentSeed(types.StringValue("17"), seed, "random_word", nil); got.ValueString() != "17" {
+		t.Errorf("component seed = %q, want the component's own seed 17", got.ValueString())
+	}
+	if got := componentSeed(types.StringNull(), types.StringNull(), "random_word", nil); !got.IsNull() {
+		t.Errorf("component seed without top-level seed = %q, want null", got.ValueString())
+	}
+
+	got := componentSeed(types.StringNull(), seed, "nanoid", nil)
+	if want := "6719fe318160e0a5a270d8c0d37903e30c4ea37da878f90d2ff1ada435620ce7"; got.ValueString() != want {
+		t.Errorf("derived seed = %q, want %q", got.ValueString(), want)
+	}
+	if other := componentSeed(types.StringNull(), seed, "components.first", nil); other.Equal(got) {
+		t.Error("components derived the same sub-seed")
+	}
+
+	// Batch entries derive a sub-seed of the component's own seed
+	index := 1
+	if got := componentSeed(types.StringValue("17"), types.StringNull(), "random_word", &index); got.ValueString() != batchEntrySeed("17", 1) {
+		t.Errorf("batch entry seed = %q, want the sub-seed of 17", got.ValueString())
+	}
+}
+
+func TestRenderBatch(t *testing.T) {
+	batch := func(templateStr string, seed types.String) (TemplatedDataSourceModel, *diag.Diagnostics) {
+		return TemplatedDataSourceModel{
+			Template:  types.StringValue(templateStr),
+			Seed:      seed,
+			BatchSize: types.Int64Value(3),
+		}, &diag.Diagnostics{}
+	}
+
+	data, diags := batch(`shard-{{ .index }}-{{ nanoid 4 }}`, types.StringValue("app"))
+	ids := renderBatch(context.Background(), data, nil, diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected errors: %v", diags)
+	}
+	expected := []string{"shard-0-AtMC", "shard-1-VOat", "shard-2-8dmD"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("renderBatch() = %v, want %v", ids, expected)
+	}
+
+	// Entries derive their seeds from the base seed and the index, so .index is not needed
+	data, diags = batch(`{{ nanoid 4 }}`, types.StringValue("app"))
+	if ids := renderBatch(context.Background(), data, nil, diags); diags.HasError() || len(ids) != 3 || ids[0] == ids[1] {
+		t.Errorf("renderBatch() = %v, %v, want 3 distinct IDs", ids, diags)
+	}
+
+	// Unseeded entries that repeat an earlier ID are rendered again
+	for i := 0; i < 20; i++ {
+		data, diags = batch(`{{ nanoid 1 "numeric" }}`, types.StringNull())
+		if ids := renderBatch(context.Background(), data, nil, diags); diags.HasError() || len(ids) != 3 || ids[0] == ids[1] || ids[1] == ids[2] || ids[0] == ids[2] {
+			t.Fatalf("renderBatch() = %v, %v, want 3 distinct IDs", ids, diags)
+		}
+	}
+
+	data, diags = batch(`shard`, types.StringNull())
+	if renderBatch(context.Background(), data, nil, diags); !diags.HasError() {
+		t.Fatal("Expected error for duplicate IDs")
+	}
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, `Entries 0 and 1 both render "shard"`) {
+		t.Errorf("Unexpected error detail: %s", detail)
+	}
+}
+
+func TestInlineGenerators(t *testing.T) {
+	render := func(templateStr string, seed types.String) (string, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		values := templateData(map[string]string{"random_word": "vivid"}, nil)
+		rendered, _ := renderTemplate(path.Root("template"), templateStr, values, newInlineGenerators(seed), &diags)
+		return rendered, diags
+	}
+
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{`{{ nanoid 6 "readable" }}-{{ proquint 2 }}-{{ word }}`, "tMesgw-hopap-litoz-suave"},
+		{`{{ nanoid 6 "readable" }}-{{ .random_word }}`, "tMesgw-vivid"},
+		// Each call has its own sub-seed
+		{`{{ nanoid 4 }}-{{ nanoid 4 }}`, "DXpB-1gJ3"},
+		{`{{ proquint }}`, "bimah-hudiv"},
+		{`{{ word "apple,banana,cherry" }}`, "cherry"},
+	}
+
+	for _, tt := range tests {
+		got, diags := render(tt.template, types.StringValue("app"))
+		if diags.HasError() {
+			t.Fatalf("template %s errors: %v", tt.template, diags)
+		}
+		if got != tt.expected {
+			t.Errorf("template %s = %q, want %q", tt.template, got, tt.expected)
+		}
+	}
+
+	// Unseeded calls are random
+	first, _ := render(`{{ nanoid 21 }}`, types.StringNull())
+	second, _ := render(`{{ nanoid 21 }}`, types.StringNull())
+	if len(first) != 21 || first == second {
+		t.Errorf("unseeded nanoid calls = %q and %q, want two different 21-character IDs", first, second)
+	}
+	// Templates rendered with the same generators share unseeded values by call position
+	generators := newInlineGenerators(types.StringNull())
+	values := templateData(nil, nil)
+	var diags diag.Diagnostics
+	id, _ := renderTemplate(path.Root("template"), `{{ nanoid 8 }}-{{ word }}`, values, generators, &diags)
+	output, _ := renderTemplate(path.Root("templates").AtMapKey("tag"), `{{ nanoid 8 }}-{{ word }}`, values, generators, &diags)
+	other, _ := renderTemplate(path.Root("templates").AtMapKey("other"), `{{ nanoid 9 }}`, values, generators, &diags)
+	if diags.HasError() || id != output {
+		t.Errorf("shared unseeded calls = %q and %q, want the same value, %v", id, output, diags)
+	}
+	if len(other) != 9 || strings.HasPrefix(id, other[:8]) {
+		t.Errorf("call with other arguments = %q, want a new 9-character ID", other)
+	}
+
+	words, _ := render(`{{ word }}-{{ word }}-{{ word }}-{{ word }}-{{ word }}`, types.StringNull())
+	if parts := strings.Split(words, "-"); strings.Count(words, parts[0]) == len(parts) {
+		t.Errorf("unseeded word calls = %q, want random words", words)
+	}
+
+	for _, invalid := range []string{
+		`{{ nanoid 0 }}`,
+		`{{ nanoid 6 "readable" "extra" }}`,
+		`{{ proquint 0 }}`,
+		`{{ proquint 1 2 }}`,
+		`{{ word "a" "b" }}`,
+	} {
+		if _, diags := render(invalid, types.StringValue("app")); !diags.HasError() {
+			t.Errorf("template %s expected error", invalid)
+		}
+	}
 }
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/iilei/terraform-provider-idgen/internal/idgen"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TypeIDDataSource{}
+
+func NewTypeIDDataSource() datasource.DataSource {
+	return &TypeIDDataSource{}
+}
+
+// TypeIDDataSource defines the data source implementation.
+type TypeIDDataSource struct{}
+
+// TypeIDDataSourceModel describes the data source data model.
+type TypeIDDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	UUID      types.String `tfsdk:"uuid"`
+	Prefix    types.String `tfsdk:"prefix"`
+	Seed      types.String `tfsdk:"seed"`
+	Timestamp types.String `tfsdk:"timestamp"`
+}
+
+func (d *TypeIDDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_typeid"
+}
+
+func (d *TypeIDDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a type-prefixed identifier following the " +
+			"[TypeID specification](https://github.com/jetify-com/typeid/tree/main/spec), " +
+			"e.g. `user_01h455vb4pex5vsknk084sn02q`.\n\n" +
+			"A TypeID consists of a lowercase prefix and a UUIDv7 encoded as 26 characters of Crockford base32. " +
+			"The UUIDv7 embeds the `timestamp` in milliseconds, so IDs generated later sort after IDs generated earlier.\n\n" +
+			"**Security Notice:** When using `seed`, IDs become deterministic and predictable. " +
+			"Never use seeded IDs for security tokens, passwords, or cryptographic purposes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The generated TypeID.",
+				Computed:    true,
+			},
+			"uuid": schema.StringAttribute{
+				Description: "The UUIDv7 underlying the TypeID, in canonical hyphenated form.",
+				Computed:    true,
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "The type prefix, e.g. `user`. At most 63 characters, lowercase letters `a-z` and " +
+					"underscores only, starting and ending with a letter. If omitted, the TypeID has no prefix and no separator.",
+				Optional: true,
+			},
+			"seed": schema.StringAttribute{
+				MarkdownDescription: "Optional seed for deterministic generation of the random part of the UUIDv7. Behavior:\n\n" +
+					"- **Integer** - parsed and used as random seed\n" +
+					"- **Text string** - hashed deterministically and used as random seed\n" +
+					"- **Omitted** - cryptographically random (different each apply)\n\n" +
+					"**Note:** The timestamp part still changes on each apply unless `timestamp` is set as well.\n\n" +
+					"**WARNING:** Seeded IDs are deterministic and should not be used for security tokens or secrets.",
+				Optional: true,
+			},
+			"timestamp": schema.StringAttribute{
+				MarkdownDescription: "Optional RFC 3339 timestamp (e.g. `2023-06-25T12:00:00Z`) embedded in the UUIDv7. " +
+					"Defaults to the current time.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *TypeIDDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider configuration is not needed for this implementation
+}
+
+func (d *TypeIDDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TypeIDDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := generateTypeID(TypeIDConfig{Prefix: data.Prefix, Seed: data.Seed, Timestamp: data.Timestamp}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, uuid, err := idgen.DecodeTypeID(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to decode TypeID",
+			"Could not decode generated TypeID: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.UUID = types.StringValue(uuid)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTypeIDDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypeIDDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.idgen_typeid.test", "id", regexp.MustCompile(`^[0-7][0-9a-hjkmnp-tv-z]{25}$`)),
+					resource.TestCheckResourceAttrSet("data.idgen_typeid.test", "uuid"),
+				),
+			},
+			{
+				Config: testAccTypeIDDataSourceConfigSeeded,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_typeid.test", "id", "user_01h3s6spg0eatads5dra4sxzrz"),
+					resource.TestCheckResourceAttr("data.idgen_typeid.test", "uuid", "0188f26c-da00-72b4-a6e4-adc2899eff1f"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTypeIDDataSourceConfig = `
+data "idgen_typeid" "test" {}
+`
+
+const testAccTypeIDDataSourceConfigSeeded = `
+data "idgen_typeid" "test" {
+  prefix    = "user"
+  seed      = "42"
+  timestamp = "2023-06-25T12:00:00Z"
+}
+`
+
+func TestAccTypeIDDataSource_Invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTypeIDDataSourceConfigInvalidPrefix,
+				ExpectError: regexp.MustCompile("Invalid TypeID prefix"),
+			},
+			{
+				Config:      testAccTypeIDDataSourceConfigInvalidTimestamp,
+				ExpectError: regexp.MustCompile("Invalid timestamp"),
+			},
+		},
+	})
+}
+
+const testAccTypeIDDataSourceConfigInvalidPrefix = `
+data "idgen_typeid" "test" {
+  prefix = "User_"
+}
+`
+
+const testAccTypeIDDataSourceConfigInvalidTimestamp = `
+data "idgen_typeid" "test" {
+  timestamp = "yesterday"
+}
+`
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/iilei/terraform-provider-idgen/internal/idgen"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WordDecodeDataSource{}
+
+func NewWordDecodeDataSource() datasource.DataSource {
+	return &WordDecodeDataSource{}
+}
+
+// WordDecodeDataSource defines the data source implementation.
+type WordDecodeDataSource struct{}
+
+// WordDecodeDataSourceModel describes the data source data model.
+type WordDecodeDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Words     types.String `tfsdk:"words"`
+	Wordlist  types.String `tfsdk:"wordlist"`
+	Separator types.String `tfsdk:"separator"`
+	Hex       types.String `tfsdk:"hex"`
+}
+
+func (d *WordDecodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_word_decode"
+}
+
+func (d *WordDecodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Decodes a word sequence created by [word_encode](./word_encode) back into the integer it encodes.\n\n" +
+			"Each word is one digit in base N, where N is the size of the word list, most significant first. " +
+			"The same word list must be used for encoding and decoding.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The decoded integer as a decimal string. " +
+					"Returned as a string so large values keep their precision; use `tonumber()` for values up to 2^53.",
+				Computed: true,
+			},
+			"words": schema.StringAttribute{
+				MarkdownDescription: "The words to decode, joined by the separator (e.g., `abdomen-older`). " +
+					"Whitespace around words is ignored.",
+				Required: true,
+			},
+			"wordlist": schema.StringAttribute{
+				MarkdownDescription: wordEncodingWordlistDescription,
+				Optional:            true,
+			},
+			"separator": schema.StringAttribute{
+				MarkdownDescription: "The separator between words. Defaults to `-`. A single space splits on any whitespace.",
+				Optional:            true,
+			},
+			"hex": schema.StringAttribute{
+				Description: "The decoded integer as lowercase hexadecimal, without prefix.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *WordDecodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider configuration is not needed for this implementation
+}
+
+func (d *WordDecodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WordDecodeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set defaults
+	separator := "-"
+	if !data.Separator.IsNull() {
+		separator = data.Separator.ValueString()
+	}
+
+	if separator == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("separator"),
+			"Invalid separator",
+			"The separator must not be empty.",
+		)
+		return
+	}
+
+	var words []string
+	if separator == " " {
+		words = strings.Fields(data.Words.ValueString())
+	} else {
+		for _, word := range strings.Split(data.Words.ValueString(), separator) {
+			words = append(words, strings.TrimSpace(word))
+		}
+	}
+
+	value, err := idgen.DecodeWords(words, resolveWordEncodingWordlist(data.Wordlist.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("words"),
+			"Invalid words",
+			"Could not decode words: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(value.String())
+	data.Hex = types.StringValue(value.Text(16))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
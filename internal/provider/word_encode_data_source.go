@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/iilei/terraform-provider-idgen/internal/data"
+	"github.com/iilei/terraform-provider-idgen/internal/idgen"
+)
+
+// wordEncodingMaxBits limits the size of values that can be encoded as words.
+const wordEncodingMaxBits = 512
+
+// wordEncodingMaxMinWords limits min_words: even a two-word list needs no more words
+// than the value has bits.
+const wordEncodingMaxMinWords = wordEncodingMaxBits
+
+// wordEncodingWordlistDescription documents the wordlist attribute shared by word_encode and word_decode.
+const wordEncodingWordlistDescription = "The word list whose words are used as digits. The list is sorted and deduplicated, " +
+	"and its size is the base of the encoding:\n\n" +
+	"- **`five_letter`** (default) - the bundled five-letter word list\n" +
+	"- **`eff_large`** - EFF large word list, base 7776\n" +
+	"- **`eff_short`** - EFF short word list 2.0, base 1296\n" +
+	"- **`bip39`** - BIP39 English word list, base 2048\n" +
+	"- **Custom** - a comma-separated string of words (e.g., `red,green,blue`)\n\n" +
+	"Encoding and decoding must use the same word list."
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WordEncodeDataSource{}
+
+func NewWordEncodeDataSource() datasource.DataSource {
+	return &WordEncodeDataSource{}
+}
+
+// WordEncodeDataSource defines the data source implementation.
+type WordEncodeDataSource struct{}
+
+// WordEncodeDataSourceModel describes the data source data model.
+type WordEncodeDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Value     types.String `tfsdk:"value"`
+	Wordlist  types.String `tfsdk:"wordlist"`
+	Separator types.String `tfsdk:"separator"`
+	MinWords  types.Int64  `tfsdk:"min_words"`
+	Base      types.Int64  `tfsdk:"base"`
+}
+
+func (d *WordEncodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_word_encode"
+}
+
+func (d *WordEncodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Encodes an integer as a sequence of words, e.g. build number `4711` as `abdomen-older` with the EFF short word list.\n\n" +
+			"The integer is written in base N, where N is the size of the word list and each word is one digit, " +
+			"most significant first. Like proquints encode 16 bits per word, this is a reversible positional encoding: " +
+			"[word_decode](./word_decode) returns the original integer.\n\n" +
+			"Values like account or build numbers become short memorable phrases; " +
+			"with the EFF large word list, any number below 7776^3 (about 470 billion) fits in three words.\n\n" +
+			"**Security Notice:** The encoding is deterministic and hides nothing; anyone with the word list can decode it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The encoded words, joined by the separator.",
+				Computed:    true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The non-negative integer to encode, as a decimal string (e.g., `\"4711\"`) " +
+					"or `0x`-prefixed hexadecimal string. Values up to 512 bits are supported.",
+				Required: true,
+			},
+			"wordlist": schema.StringAttribute{
+				MarkdownDescription: wordEncodingWordlistDescription,
+				Optional:            true,
+			},
+			"separator": schema.StringAttribute{
+				MarkdownDescription: "The separator placed between words. Must not be empty. Defaults to `-`.",
+				Optional:            true,
+			},
+			"min_words": schema.Int64Attribute{
+				MarkdownDescription: "Pads the result to at least this many words using the first word of the list " +
+					"(the equivalent of leading zeros), for fixed-width phrases. Must be between 1 and 512. Defaults to 1.",
+				Optional: true,
+			},
+			"base": schema.Int64Attribute{
+				Description: "The base of the encoding, i.e. the number of distinct words in the word list.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *WordEncodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider configuration is not needed for this implementation
+}
+
+// resolveWordEncodingWordlist returns the sorted, deduplicated word list for a preset name
+// or a comma-separated custom list.
+func resolveWordEncodingWordlist(input string) []string {
+	switch input {
+	case "", "five_letter":
+		return idgen.SortedWordlist(data.FiveLetterWords)
+	case "eff_large":
+		return idgen.SortedWordlist(data.EFFLargeWords)
+	case "eff_short":
+		return idgen.SortedWordlist(data.EFFShortWords)
+	case "bip39":
+		return idgen.SortedWordlist(data.BIP39English)
+	}
+	return idgen.SortedWordlist(parseWordlist(input))
+}
+
+// parseWordEncodingValue parses a decimal or 0x-prefixed hexadecimal non-negative integer.
+// Returns (value, error) where error is a description if parsing failed.
+func parseWordEncodingValue(s string) (*big.Int, string) {
+	value, ok := new(big.Int), false
+	if hexStr, isHex := strings.CutPrefix(strings.ToLower(s), "0x"); isHex {
+		_, ok = value.SetString(hexStr, 16)
+	} else {
+		_, ok = value.SetString(s, 10)
+	}
+
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Sprintf("The value '%s' is not a non-negative decimal or 0x-prefixed hexadecimal integer", s)
+	}
+	if value.BitLen() > wordEncodingMaxBits {
+		return nil, fmt.Sprintf("The value '%s' exceeds the maximum of %d bits", s, wordEncodingMaxBits)
+	}
+	return value, ""
+}
+
+func (d *WordEncodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WordEncodeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, errMsg := parseWordEncodingValue(data.Value.ValueString())
+	if errMsg != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid value", errMsg)
+		return
+	}
+
+	// Set defaults
+	separator := "-"
+	if !data.Separator.IsNull() {
+		separator = data.Separator.ValueString()
+	}
+
+	if separator == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("separator"),
+			"Invalid separator",
+			"The separator must not be empty, otherwise the words cannot be decoded again.",
+		)
+		return
+	}
+
+	minWords := int64(1)
+	if !data.MinWords.IsNull() {
+		minWords = data.MinWords.ValueInt64()
+	}
+
+	if minWords < 1 || minWords > wordEncodingMaxMinWords {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_words"),
+			"Invalid min_words",
+			fmt.Sprintf("min_words must be between 1 and %d, got %d.", wordEncodingMaxMinWords, minWords),
+		)
+		return
+	}
+
+	wordlist := resolveWordEncodingWordlist(data.Wordlist.ValueString())
+
+	words, err := idgen.EncodeWords(value, wordlist, int(minWords))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to encode value as words",
+			"Could not encode value: "+err.Error(),
+		)
+		return
+	}
+
+	// A word containing the separator would make the result impossible to decode
+	for _, word := range words {
+		if strings.Contains(word, separator) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("separator"),
+				"Word contains separator",
+				fmt.Sprintf("The word '%s' contains the separator '%s', so the result could not be decoded again. "+
+					"Choose a different separator.", word, separator),
+			)
+			return
+		}
+	}
+
+	data.ID = types.StringValue(strings.Join(words, separator))
+	data.Base = types.Int64Value(int64(len(wordlist)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWordEncodeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWordEncodeDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_word_encode.test", "id", "rural-suave-rural"),
+					resource.TestCheckResourceAttr("data.idgen_word_encode.test", "base", "20"),
+				),
+			},
+			{
+				Config: testAccWordEncodeDataSourceConfigShort,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_word_encode.test", "id", "abdomen-older"),
+					resource.TestCheckResourceAttr("data.idgen_word_encode.test", "base", "1296"),
+				),
+			},
+			{
+				Config: testAccWordEncodeDataSourceConfigPadded,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_word_encode.test", "id", "afoot.afoot.sheer.suave"),
+				),
+			},
+			{
+				Config: testAccWordEncodeDataSourceConfigRoundTrip,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_word_encode.test", "id", "dupe sharpie remold"),
+					resource.TestCheckResourceAttr("data.idgen_word_decode.test", "id", "123456789012"),
+					resource.TestCheckResourceAttr("data.idgen_word_decode.test", "hex", "1cbe991a14"),
+				),
+			},
+			{
+				Config:      testAccWordEncodeDataSourceConfigNegative,
+				ExpectError: regexp.MustCompile("Invalid value"),
+			},
+			{
+				Config:      testAccWordEncodeDataSourceConfigMinWords(0),
+				ExpectError: regexp.MustCompile("min_words must be between 1 and 512, got 0"),
+			},
+			{
+				Config:      testAccWordEncodeDataSourceConfigMinWords(513),
+				ExpectError: regexp.MustCompile("min_words must be between 1 and 512, got 513"),
+			},
+		},
+	})
+}
+
+func TestAccWordDecodeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWordDecodeDataSourceConfigCustom,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.idgen_word_decode.test", "id", "5"),
+				),
+			},
+			{
+				Config:      testAccWordDecodeDataSourceConfigUnknownWord,
+				ExpectError: regexp.MustCompile("Invalid words"),
+			},
+		},
+	})
+}
+
+func testAccWordEncodeDataSourceConfigMinWords(minWords int) string {
+	return fmt.Sprintf(`
+data "idgen_word_encode" "test" {
+  value     = "4711"
+  min_words = %d
+}
+`, minWords)
+}
+
+const testAccWordEncodeDataSourceConfig = `
+data "idgen_word_encode" "test" {
+  value = "4711"
+}
+`
+
+const testAccWordEncodeDataSourceConfigShort = `
+data "idgen_word_encode" "test" {
+  value    = "4711"
+  wordlist = "eff_short"
+}
+`
+
+const testAccWordEncodeDataSourceConfigPadded = `
+data "idgen_word_encode" "test" {
+  value     = "0xff"
+  separator = "."
+  min_words = 4
+}
+`
+
+const testAccWordEncodeDataSourceConfigRoundTrip = `
+data "idgen_word_encode" "test" {
+  value     = "123456789012"
+  wordlist  = "eff_large"
+  separator = " "
+}
+
+data "idgen_word_decode" "test" {
+  words     = data.idgen_word_encode.test.id
+  wordlist  = "eff_large"
+  separator = " "
+}
+`
+
+const testAccWordEncodeDataSourceConfigNegative = `
+data "idgen_word_encode" "test" {
+  value = "-1"
+}
+`
+
+const testAccWordDecodeDataSourceConfigCustom = `
+data "idgen_word_decode" "test" {
+  words    = "blue-blue-green-red"
+  wordlist = "red,green,blue"
+}
+`
+
+const testAccWordDecodeDataSourceConfigUnknownWord = `
+data "idgen_word_decode" "test" {
+  words    = "green-purple"
+  wordlist = "red,green,blue"
+}
+`